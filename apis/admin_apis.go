@@ -0,0 +1,77 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/consistency"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultConsistencyGracePeriod = 10 * time.Minute
+
+/*
+This API cross-checks the registrations against the stored artifacts, the inconsistencies
+are only reported unless the repair and deleteOrphans query parameters are set.
+*/
+func (m *MmeApiHandler) CheckConsistency(cont *gin.Context) {
+	logging.INFO("Consistency check API ...")
+	options := consistency.Options{GracePeriod: defaultConsistencyGracePeriod}
+
+	var err error
+	if value := cont.Query("repair"); value != "" {
+		options.Repair, err = strconv.ParseBool(value)
+	}
+	if value := cont.Query("deleteOrphans"); err == nil && value != "" {
+		options.DeleteOrphans, err = strconv.ParseBool(value)
+	}
+	if value := cont.Query("gracePeriod"); err == nil && value != "" {
+		if options.GracePeriod, err = time.ParseDuration(value); err == nil && options.GracePeriod < 0 {
+			err = fmt.Errorf("gracePeriod can't be negative")
+		}
+	}
+	if err != nil {
+		statusCode := http.StatusBadRequest
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Bad Request",
+			Detail: fmt.Sprintf("The query parameters are not correct, %s", err.Error()),
+		})
+		return
+	}
+
+	report, err := consistency.NewChecker(m.dbmgr, m.auditedDB(cont), os.Getenv("MODEL_FILE_POSTFIX")).Run(options)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		logging.ERROR("Consistency check failed", "error", err)
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Internal Server Error",
+			Detail: fmt.Sprintf("Consistency check failed, %s", err.Error()),
+		})
+		return
+	}
+	cont.JSON(http.StatusOK, report)
+}
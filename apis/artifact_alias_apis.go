@@ -0,0 +1,192 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// Resolves an artifact version or alias of the model, writes the error response and returns false
+// when it can't. Artifact versions are returned as they are, without looking up the registry.
+func (m *MmeApiHandler) resolveArtifactVersion(cont *gin.Context, modelName string, modelVersion string, ref string) (string, bool) {
+	if _, err := utils.ParseArtifactVersion(ref); err == nil {
+		return ref, true
+	}
+	artifactVersion, err := m.iDB.ResolveArtifactAlias(modelName, modelVersion, ref)
+	if errors.Is(err, db.ErrNotFound) {
+		statusCode := http.StatusNotFound
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Found",
+			Detail: fmt.Sprintf("Artifact alias %s not found for modelName: %s and modelVersion: %s", ref, modelName, modelVersion),
+		})
+		return "", false
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't resolve the artifact alias due to , %s", err.Error()))
+		return "", false
+	}
+	return artifactVersion, true
+}
+
+// Refuses to serve a revoked artifact version, writes the error response and returns false for it.
+// Artifacts without registration or history, as stored by the previous releases, are served.
+func (m *MmeApiHandler) checkArtifactNotRevoked(cont *gin.Context, modelName string, modelVersion string, artifactVersion string) bool {
+	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
+	if err == nil {
+		var artifact *models.ArtifactVersion
+		artifact, err = m.iDB.GetArtifactVersion(modelInfo.Id, artifactVersion)
+		if err == nil && artifact.Status == models.ArtifactStatusRevoked {
+			statusCode := http.StatusGone
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Gone",
+				Detail: fmt.Sprintf("artifactVersion %s of modelName: %s and modelVersion: %s is revoked", artifactVersion, modelName, modelVersion),
+			})
+			return false
+		}
+	}
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		writeError(cont, err, fmt.Sprintf("Can't check the artifact version due to , %s", err.Error()))
+		return false
+	}
+	return true
+}
+
+// Checks the alias in the path can be set or deleted, writes the error response and returns false when it can't
+func checkAliasName(cont *gin.Context, alias string) bool {
+	detail := ""
+	switch {
+	case alias == models.AliasLatest:
+		detail = "The latest alias is maintained by the registry and can't be changed"
+	case !utils.ValidArtifactAlias(alias):
+		detail = fmt.Sprintf("Invalid alias %q, aliases are lowercase letters, digits, '-' and '_' starting with a letter", alias)
+	default:
+		return true
+	}
+	statusCode := http.StatusBadRequest
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Bad Request",
+		Detail: detail,
+	})
+	return false
+}
+
+/*
+This API lists the aliases of a registration, including latest which follows the current artifact version
+*/
+func (m *MmeApiHandler) ListArtifactAliases(cont *gin.Context) {
+	logging.INFO("List artifact aliases API ...")
+	id := cont.Param("modelRegistrationId")
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	aliases, err := m.iDB.ListArtifactAliases(id)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't list the artifact aliases due to , %s", err.Error()))
+		return
+	}
+	cont.JSON(http.StatusOK, aliases)
+}
+
+/*
+This API creates an alias for an artifact version of a registration, or moves it when it already exists
+*/
+func (m *MmeApiHandler) SetArtifactAlias(cont *gin.Context) {
+	logging.INFO("Set artifact alias API ...")
+	id := cont.Param("modelRegistrationId")
+	alias := cont.Param("alias")
+
+	if !checkAliasName(cont, alias) {
+		return
+	}
+	var request models.ArtifactAliasRequest
+	if err := cont.ShouldBindJSON(&request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
+		return
+	}
+	if err := requestValidator.Struct(request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct as it can't be validated, %s", err.Error()))
+		return
+	}
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	artifactAlias, err := m.auditedDB(cont).SetArtifactAlias(id, alias, request.ArtifactVersion)
+	if errors.Is(err, db.ErrNotFound) {
+		artifactVersionNotFound(cont, id, request.ArtifactVersion)
+		return
+	}
+	if errors.Is(err, db.ErrArtifactVersionRevoked) {
+		statusCode := http.StatusConflict
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Conflict",
+			Detail: fmt.Sprintf("artifact version %s is revoked and can't be aliased", request.ArtifactVersion),
+		})
+		return
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't set the artifact alias due to , %s", err.Error()))
+		return
+	}
+	logging.INFO("Artifact alias set", "id", id, "alias", alias, "artifactVersion", request.ArtifactVersion)
+	cont.JSON(http.StatusOK, artifactAlias)
+}
+
+func (m *MmeApiHandler) DeleteArtifactAlias(cont *gin.Context) {
+	logging.INFO("Delete artifact alias API ...")
+	id := cont.Param("modelRegistrationId")
+	alias := cont.Param("alias")
+
+	if !checkAliasName(cont, alias) {
+		return
+	}
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	rows, err := m.auditedDB(cont).DeleteArtifactAlias(id, alias)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't delete the artifact alias due to , %s", err.Error()))
+		return
+	}
+	if rows == 0 {
+		statusCode := http.StatusNotFound
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Found",
+			Detail: fmt.Sprintf("artifact alias %s not found for model id: %s", alias, id),
+		})
+		return
+	}
+	cont.Status(http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// Lists the artifacts stored for the model, ordered by artifact version
+func (m *MmeApiHandler) listModelArtifacts(modelInfo *models.ModelRelatedInformation) ([]models.ArtifactInfo, error) {
+	modelName := modelInfo.ModelId.ModelName
+	modelVersion := modelInfo.ModelId.ModelVersion
+	items, err := m.dbmgr.GetBucketItems(utils.ModelBucketName(modelName))
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := []models.ArtifactInfo{}
+	for _, item := range items {
+		artifactVersion, ok := utils.ParseModelObjectName(item.Name, modelName, modelVersion, os.Getenv("MODEL_FILE_POSTFIX"))
+		if !ok {
+			continue
+		}
+		artifact := models.ArtifactInfo{
+			ArtifactVersion: artifactVersion,
+			ObjectName:      item.Name,
+			Size:            item.Size,
+			LastModified:    item.LastModified,
+			Current:         artifactVersion == modelInfo.ModelId.ArtifactVersion,
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		return utils.CompareArtifactVersions(artifacts[i].ArtifactVersion, artifacts[j].ArtifactVersion) < 0
+	})
+	return artifacts, nil
+}
+
+/*
+This API lists the artifacts actually stored for a registered model version
+*/
+func (m *MmeApiHandler) ListModelArtifacts(cont *gin.Context) {
+	logging.INFO("List model artifacts API ...")
+	modelName := cont.Param("modelName")
+	modelVersion := cont.Param("modelVersion")
+
+	modelInfo, ok := m.getRegisteredModel(cont, modelName, modelVersion)
+	if !ok {
+		return
+	}
+
+	artifacts, err := m.listModelArtifacts(modelInfo)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't list the artifacts due to , %s", err.Error()))
+		return
+	}
+
+	// the checksums are recorded in the history, older registrations only kept the current one
+	history, err := m.iDB.ListArtifactVersions(modelInfo.Id)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't list the artifacts due to , %s", err.Error()))
+		return
+	}
+	checksums := map[string]string{modelInfo.ModelId.ArtifactVersion: modelInfo.ArtifactChecksum}
+	for _, recorded := range history {
+		if recorded.Digest != "" {
+			checksums[recorded.Version] = recorded.Digest
+		}
+	}
+	for i := range artifacts {
+		artifacts[i].Checksum = checksums[artifacts[i].ArtifactVersion]
+	}
+
+	cont.JSON(http.StatusOK, models.ModelArtifactsResponse{
+		ModelInfo: *modelInfo,
+		Artifacts: artifacts,
+	})
+}
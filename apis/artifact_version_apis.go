@@ -0,0 +1,137 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Header carrying the identity of the caller, recorded as the uploader of an artifact
+const actorHeader = "X-Actor"
+
+func requestActor(cont *gin.Context) string {
+	return cont.GetHeader(actorHeader)
+}
+
+// Fetches the registration by id, writes the error response and returns false when it can't
+func (m *MmeApiHandler) getRegistration(cont *gin.Context, id string) (*models.ModelRelatedInformation, bool) {
+	modelInfo, err := m.iDB.GetModelInfoById(id)
+	if errors.Is(err, db.ErrNotFound) {
+		modelNotFound(cont, id)
+		return nil, false
+	}
+	if err != nil {
+		writeError(cont, err, err.Error())
+		return nil, false
+	}
+	return modelInfo, true
+}
+
+func artifactVersionNotFound(cont *gin.Context, id string, artifactVersion string) {
+	statusCode := http.StatusNotFound
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Not Found",
+		Detail: fmt.Sprintf("artifact version %s not found for model id: %s", artifactVersion, id),
+	})
+}
+
+/*
+This API lists the history of the artifact versions committed for a registration
+*/
+func (m *MmeApiHandler) ListArtifactVersions(cont *gin.Context) {
+	logging.INFO("List artifact versions API ...")
+	id := cont.Param("modelRegistrationId")
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	artifacts, err := m.iDB.ListArtifactVersions(id)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't list the artifact versions due to , %s", err.Error()))
+		return
+	}
+	if artifacts == nil {
+		artifacts = []models.ArtifactVersion{}
+	}
+	cont.JSON(http.StatusOK, artifacts)
+}
+
+func (m *MmeApiHandler) GetArtifactVersion(cont *gin.Context) {
+	logging.INFO("Get artifact version API ...")
+	id := cont.Param("modelRegistrationId")
+	artifactVersion := cont.Param("artifactVersion")
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	artifact, err := m.iDB.GetArtifactVersion(id, artifactVersion)
+	if errors.Is(err, db.ErrNotFound) {
+		artifactVersionNotFound(cont, id, artifactVersion)
+		return
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't fetch the artifact version due to , %s", err.Error()))
+		return
+	}
+	cont.JSON(http.StatusOK, artifact)
+}
+
+/*
+This API marks an artifact version as available, deprecated or revoked, the artifact itself is left untouched
+*/
+func (m *MmeApiHandler) MarkArtifactVersion(cont *gin.Context) {
+	logging.INFO("Mark artifact version API ...")
+	id := cont.Param("modelRegistrationId")
+	artifactVersion := cont.Param("artifactVersion")
+
+	var request models.ArtifactStatusRequest
+	if err := cont.ShouldBindJSON(&request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
+		return
+	}
+	if err := requestValidator.Struct(request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct as it can't be validated, %s", err.Error()))
+		return
+	}
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	artifact, err := m.auditedDB(cont).SetArtifactVersionStatus(id, artifactVersion, request.Status)
+	if errors.Is(err, db.ErrNotFound) {
+		artifactVersionNotFound(cont, id, artifactVersion)
+		return
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't mark the artifact version due to , %s", err.Error()))
+		return
+	}
+	logging.INFO("Artifact version marked", "id", id, "artifactVersion", artifactVersion, "status", request.Status)
+	cont.JSON(http.StatusOK, artifact)
+}
@@ -0,0 +1,81 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"fmt"
+	"net/http"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIdHeader = "X-Request-Id"
+	// longest request id accepted from the caller, a new one is generated otherwise
+	maxRequestIdLength = 128
+)
+
+// RequestId tags every request with the X-Request-Id given by the caller, or a new one,
+// it is echoed in the response and recorded in the audit trail
+func RequestId() gin.HandlerFunc {
+	return func(cont *gin.Context) {
+		requestId := cont.GetHeader(requestIdHeader)
+		if requestId == "" || len(requestId) > maxRequestIdLength {
+			requestId = uuid.NewString()
+		}
+		cont.Set(requestIdHeader, requestId)
+		cont.Header(requestIdHeader, requestId)
+		cont.Next()
+	}
+}
+
+func auditContext(cont *gin.Context) models.AuditContext {
+	return models.AuditContext{
+		Actor:     requestActor(cont),
+		RequestId: cont.GetString(requestIdHeader),
+	}
+}
+
+// Returns the IDB recording the changes in the audit trail on behalf of the caller of the request
+func (m *MmeApiHandler) auditedDB(cont *gin.Context) db.IDB {
+	return m.iDB.WithAudit(auditContext(cont))
+}
+
+// ListAuditEvents returns the audit trail of a registration, oldest first. The trail outlives
+// the registration, so it is still returned once the registration is deleted or purged.
+func (m *MmeApiHandler) ListAuditEvents(cont *gin.Context) {
+	id := cont.Param("modelRegistrationId")
+	logging.INFO("Listing audit events... id = ", id)
+
+	events, err := m.iDB.ListAuditEvents(id)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't fetch the audit events due to , %s", err.Error()))
+		return
+	}
+	// registrations made before the audit trail existed have none
+	if len(events) == 0 {
+		if _, ok := m.getRegistration(cont, id); !ok {
+			return
+		}
+	}
+	cont.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,133 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultModelDeleteRetention = 30 * 24 * time.Hour
+
+func modelDeleteRetention() time.Duration {
+	if retention, err := time.ParseDuration(os.Getenv("MODEL_DELETE_RETENTION")); err == nil && retention >= 0 {
+		return retention
+	}
+	return defaultModelDeleteRetention
+}
+
+// RestoreModel brings back a deleted registration which is not purged yet
+func (m *MmeApiHandler) RestoreModel(cont *gin.Context) {
+	id := cont.Param("modelRegistrationId")
+	logging.INFO("Restoring model... id = ", id)
+
+	modelInfo, err := m.auditedDB(cont).Restore(id)
+	if errors.Is(err, db.ErrNotFound) {
+		statusCode := http.StatusNotFound
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Found",
+			Detail: fmt.Sprintf("deleted model not found with id: %s", id),
+		})
+		return
+	}
+	if err != nil {
+		writeError(cont, err, err.Error())
+		return
+	}
+	cont.JSON(http.StatusOK, modelInfo)
+}
+
+/*
+PurgeDeletedModels permanently removes the registrations deleted for longer than the
+MODEL_DELETE_RETENTION, their artifacts are removed as well under the cascade policy.
+A registration failing to be purged is left for the next run.
+*/
+func (m *MmeApiHandler) PurgeDeletedModels(now time.Time, audit models.AuditContext) ([]models.DeleteModelReport, error) {
+	deleted, err := m.iDB.ListDeleted(now.Add(-modelDeleteRetention()))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := modelDeletePolicy()
+	reports := []models.DeleteModelReport{}
+	for i := range deleted {
+		modelInfo := &deleted[i]
+		report := models.DeleteModelReport{
+			Id:             modelInfo.Id,
+			Policy:         policy,
+			RemovedObjects: []string{},
+		}
+
+		var artifacts []models.ArtifactInfo
+		if policy == config.DELETE_POLICY_CASCADE {
+			if artifacts, err = m.listModelArtifacts(modelInfo); err != nil {
+				logging.ERROR("Unable to list artifacts, not purged", "id", modelInfo.Id, "error", err)
+				continue
+			}
+		}
+		rows, err := m.iDB.WithAudit(audit).Purge(modelInfo.Id)
+		if err != nil {
+			logging.ERROR("Unable to purge the registration", "id", modelInfo.Id, "error", err)
+			continue
+		}
+		if rows == 0 {
+			// restored in the meantime
+			continue
+		}
+
+		// The registration is gone first, artifacts failing to be removed are only left orphaned
+		if len(artifacts) > 0 {
+			m.removeModelArtifacts(modelInfo, artifacts, &report)
+		}
+		logging.INFO("Registration purged", "id", modelInfo.Id, "removed", len(report.RemovedObjects))
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// This API purges the registrations past their retention right away, without waiting for the periodic purge
+func (m *MmeApiHandler) PurgeDeletedModelsNow(cont *gin.Context) {
+	logging.INFO("Purge API ...")
+	reports, err := m.PurgeDeletedModels(time.Now(), auditContext(cont))
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't purge the deleted models due to , %s", err.Error()))
+		return
+	}
+	cont.JSON(http.StatusOK, reports)
+}
+
+// PurgeDeletedModelsEvery runs PurgeDeletedModels at every interval, it never returns
+func (m *MmeApiHandler) PurgeDeletedModelsEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		if _, err := m.PurgeDeletedModels(now, models.AuditContext{Actor: models.SystemActor}); err != nil {
+			logging.ERROR("Unable to purge the deleted registrations", "error", err)
+		}
+	}
+}
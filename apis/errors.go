@@ -0,0 +1,199 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// Media type of the error responses, RFC 7807
+const problemContentType = "application/problem+json"
+
+// Validates the request bodies, the invalid fields are reported by their JSON name
+var requestValidator = newRequestValidator()
+
+func newRequestValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return validate
+}
+
+// Statuses of the errors of the repository, the storage and the artifact versioning the client can act on,
+// they don't depend on the database or the storage backend. Any other error is an internal one.
+var errorStatuses = []struct {
+	err        error
+	statusCode int
+}{
+	{db.ErrNotFound, http.StatusNotFound},
+	{core.ErrObjectNotFound, http.StatusNotFound},
+	{db.ErrConflict, http.StatusConflict},
+	{db.ErrStale, http.StatusConflict},
+	{db.ErrArtifactVersionConflict, http.StatusConflict},
+	{db.ErrArtifactVersionRevoked, http.StatusConflict},
+	{db.ErrLifecycleTransition, http.StatusConflict},
+	{utils.ErrArtifactVersionNotIncreased, http.StatusConflict},
+	{utils.ErrInvalidArtifactVersion, http.StatusBadRequest},
+	{utils.ErrChecksumMismatch, http.StatusBadRequest},
+	{utils.ErrRangeNotSatisfiable, http.StatusRequestedRangeNotSatisfiable},
+	{core.ErrNotSupported, http.StatusNotImplemented},
+}
+
+// errorStatus returns the HTTP status reporting the error
+func errorStatus(err error) int {
+	for _, errorStatus := range errorStatuses {
+		if errors.Is(err, errorStatus.err) {
+			return errorStatus.statusCode
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// errorProblem maps the error to the problem reported to the client along with the detail of the failure
+func errorProblem(err error, detail string) models.ProblemDetail {
+	statusCode := errorStatus(err)
+	return models.ProblemDetail{
+		Status: statusCode,
+		Title:  http.StatusText(statusCode),
+		Detail: detail,
+	}
+}
+
+// writeError writes the problem of the error, the internal errors are logged
+func writeError(cont *gin.Context, err error, detail string) {
+	problem := errorProblem(err, detail)
+	if problem.Status == http.StatusInternalServerError {
+		logging.ERROR(detail, "error", err)
+	}
+	writeProblem(cont, problem)
+}
+
+// writeProblem writes the problem as application/problem+json, the instance is the requested path
+// unless the problem tells otherwise
+func writeProblem(cont *gin.Context, problem models.ProblemDetail) {
+	if problem.Type == "" {
+		problem.Type = models.ProblemTypeBlank
+	}
+	if problem.Title == "" {
+		problem.Title = http.StatusText(problem.Status)
+	}
+	if problem.Instance == "" {
+		problem.Instance = cont.Request.URL.Path
+	}
+	cont.Header("Content-Type", problemContentType)
+	cont.JSON(problem.Status, problem)
+}
+
+// writeInvalidRequest writes the 400 problem of a request which can't be decoded or validated,
+// the fields at fault are listed along with the detail
+func writeInvalidRequest(cont *gin.Context, err error, detail string) {
+	writeProblem(cont, models.ProblemDetail{
+		Status:        http.StatusBadRequest,
+		Title:         "Bad Request",
+		Detail:        detail,
+		InvalidParams: invalidParams(err),
+	})
+}
+
+// Lists the fields the decoding or the validation of the request body failed on, as JSON pointers,
+// or the query parameter at fault
+func invalidParams(err error) []models.InvalidParam {
+	var queryError *queryParamError
+	if errors.As(err, &queryError) {
+		return []models.InvalidParam{{Param: queryError.param, Reason: queryError.message}}
+	}
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		return []models.InvalidParam{{
+			Param:  jsonPointer(typeError.Field),
+			Reason: fmt.Sprintf("expected %s, got %s", typeError.Type, typeError.Value),
+		}}
+	}
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return nil
+	}
+	params := make([]models.InvalidParam, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		// the namespace starts with the name of the validated struct
+		_, path, _ := strings.Cut(fieldError.Namespace(), ".")
+		params = append(params, models.InvalidParam{Param: jsonPointer(path), Reason: invalidReason(fieldError)})
+	}
+	return params
+}
+
+// Turns the path of a field, as modelInformation.targetEnvironment[0].platformName, into a JSON pointer
+func jsonPointer(path string) string {
+	return "/" + strings.NewReplacer(".", "/", "[", "/", "]", "").Replace(path)
+}
+
+func invalidReason(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", fieldError.Param())
+	}
+	if fieldError.Param() != "" {
+		return fmt.Sprintf("must satisfy %s=%s", fieldError.Tag(), fieldError.Param())
+	}
+	return fmt.Sprintf("must satisfy %s", fieldError.Tag())
+}
+
+// RouteNotFound answers the requests no route matches with a problem
+func RouteNotFound(cont *gin.Context) {
+	writeProblem(cont, models.ProblemDetail{
+		Status: http.StatusNotFound,
+		Title:  "Not Found",
+		Detail: fmt.Sprintf("no resource at %s %s", cont.Request.Method, cont.Request.URL.Path),
+	})
+}
+
+// Recovery answers with a problem the requests whose handler panicked, the panic is logged by gin.
+// http.ErrAbortHandler is panicked again for the server to cut the connection.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(cont *gin.Context, recovered any) {
+		if recovered == http.ErrAbortHandler {
+			panic(recovered)
+		}
+		writeProblem(cont, models.ProblemDetail{
+			Status: http.StatusInternalServerError,
+			Title:  "Internal Server Error",
+			Detail: "the request can't be processed",
+		})
+		cont.Abort()
+	})
+}
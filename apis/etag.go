@@ -0,0 +1,78 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Registrations are versioned by their row version, exposed as a strong ETag
+func etag(rowVersion int64) string {
+	return strconv.Quote(strconv.FormatInt(rowVersion, 10))
+}
+
+/*
+Returns the row version the If-Match header of the request requires. conditional is false when
+there is no If-Match header or when it is "*", any version then matches. ok is false when the
+header can't match any version, as a weak or malformed ETag.
+*/
+func ifMatchVersion(cont *gin.Context) (rowVersion int64, conditional bool, ok bool) {
+	ifMatch := strings.TrimSpace(cont.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, false, true
+	}
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil || !strings.HasPrefix(ifMatch, `"`) {
+		return 0, true, false
+	}
+	rowVersion, err = strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || rowVersion <= 0 {
+		return 0, true, false
+	}
+	return rowVersion, true, true
+}
+
+func preconditionFailed(cont *gin.Context, id string) {
+	statusCode := http.StatusPreconditionFailed
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Precondition Failed",
+		Detail: fmt.Sprintf("model with id: %s was modified since it was read, fetch it again", id),
+	})
+}
+
+// Writes the response of a change rejected with db.ErrStale, 412 when the caller gave the version
+// the change was based on, 409 when the registration only changed while the request was processed
+func staleWrite(cont *gin.Context, id string, conditional bool) {
+	if conditional {
+		preconditionFailed(cont, id)
+		return
+	}
+	statusCode := http.StatusConflict
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Conflict",
+		Detail: fmt.Sprintf("model with id: %s was modified concurrently, try again", id),
+	})
+}
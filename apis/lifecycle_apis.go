@@ -0,0 +1,83 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+/*
+This API moves a registration to another lifecycle state, the caller given by the X-Actor header
+and the reason of the transition are recorded along with it
+*/
+func (m *MmeApiHandler) TransitionLifecycleState(cont *gin.Context) {
+	logging.INFO("Lifecycle transition API ...")
+	id := cont.Param("modelRegistrationId")
+
+	var request models.LifecycleTransitionRequest
+	if err := cont.ShouldBindJSON(&request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
+		return
+	}
+	if err := requestValidator.Struct(request); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct as it can't be validated, %s", err.Error()))
+		return
+	}
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	transition, err := m.auditedDB(cont).TransitionLifecycleState(id, request.State, requestActor(cont), request.Reason)
+	if errors.Is(err, db.ErrNotFound) {
+		modelNotFound(cont, id)
+		return
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't change the lifecycle state due to , %s", err.Error()))
+		return
+	}
+	logging.INFO("Lifecycle state changed", "id", id, "from", transition.FromState, "to", transition.ToState)
+	cont.JSON(http.StatusOK, transition)
+}
+
+/*
+This API lists the lifecycle transitions of a registration, oldest first
+*/
+func (m *MmeApiHandler) ListLifecycleTransitions(cont *gin.Context) {
+	logging.INFO("List lifecycle transitions API ...")
+	id := cont.Param("modelRegistrationId")
+
+	if _, ok := m.getRegistration(cont, id); !ok {
+		return
+	}
+
+	transitions, err := m.iDB.ListLifecycleTransitions(id)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't list the lifecycle transitions due to , %s", err.Error()))
+		return
+	}
+	cont.JSON(http.StatusOK, transitions)
+}
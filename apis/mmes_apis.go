@@ -23,24 +23,27 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
-	"github.com/jackc/pgerrcode"
-	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 const (
-	MODELNAME    = "model-name"
-	MODELVERSION = "model-version"
+	MODELNAME       = "model-name"
+	MODELVERSION    = "model-version"
+	ARTIFACTVERSION = "artifact-version"
+	LIFECYCLESTATE  = "lifecycle-state"
 )
 
 type MmeApiHandler struct {
@@ -62,77 +65,74 @@ func (m *MmeApiHandler) RegisterModel(cont *gin.Context) {
 	var modelInfo models.ModelRelatedInformation
 
 	if err := cont.ShouldBindJSON(&modelInfo); err != nil {
-		cont.JSON(http.StatusBadRequest, models.ProblemDetail{
-			Status: http.StatusBadRequest,
-			Title:  "Bad Request",
-			Detail: fmt.Sprintf("The request json is not correct, %s", err.Error()),
-		})
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
 		return
 	}
 
 	id := uuid.New()
 	modelInfo.Id = id.String()
 
-	validate := validator.New()
-	if err := validate.Struct(modelInfo); err != nil {
-		cont.JSON(http.StatusBadRequest, models.ProblemDetail{
-			Status: http.StatusBadRequest,
-			Title:  "Bad Request",
-			Detail: fmt.Sprintf("The request json is not correct as it can't be validated, %s", err.Error()),
-		})
+	if err := requestValidator.Struct(modelInfo); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct as it can't be validated, %s", err.Error()))
 		return
 	}
 
 	// by default when a model is registered its artifact version is set to 0.0.0
 	modelInfo.ModelId.ArtifactVersion = "0.0.0"
-
-	if err := m.iDB.Create(modelInfo); err != nil {
-		logging.ERROR("error", err)
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) {
-			if pqErr.Code == pgerrcode.UniqueViolation {
-				cont.JSON(http.StatusConflict, models.ProblemDetail{
-					Status: http.StatusConflict,
-					Title:  "Conflict",
-					Detail: "model name and version combination already present",
-				})
-				return
+	modelInfo.LifecycleState = models.LifecycleRegistered
+	modelInfo.CreatedAt = time.Time{}
+	modelInfo.DeletedAt = gorm.DeletedAt{}
+	modelInfo.DeletedBy = ""
+
+	if err := m.auditedDB(cont).Create(modelInfo); err != nil {
+		if errors.Is(err, db.ErrConflict) {
+			detail := "model name and version combination already present"
+			// the deleted registrations keep their model name and version until they are purged
+			deleted, _, findErr := m.iDB.Find(models.ModelInfoQuery{
+				ModelName:      modelInfo.ModelId.ModelName,
+				ModelVersion:   modelInfo.ModelId.ModelVersion,
+				IncludeDeleted: true,
+				Limit:          1,
+			})
+			if findErr == nil && len(deleted) == 1 && deleted[0].DeletedAt.Valid {
+				detail = fmt.Sprintf("model name and version combination belongs to the deleted registration %s, restore it with POST /ai-ml-model-registration/v1/model-registrations/%s/restore or register again once it is purged",
+					deleted[0].Id, deleted[0].Id)
 			}
-			cont.JSON(http.StatusInternalServerError, models.ProblemDetail{
-				Status: http.StatusInternalServerError,
-				Title:  "Internal Server Error",
-				Detail: fmt.Sprintf("Database error: %s", err.Error()),
+			writeProblem(cont, models.ProblemDetail{
+				Status: http.StatusConflict,
+				Title:  "Conflict",
+				Detail: detail,
 			})
 			return
 		}
+		writeError(cont, err, fmt.Sprintf("Database error: %s", err.Error()))
+		return
 	}
 
 	logging.INFO("model is saved.")
 	cont.Header("Location", "ai-ml-model-registration/v1/model-registrations/"+id.String())
+	cont.Header("ETag", etag(1))
 	cont.JSON(http.StatusCreated, gin.H{
 		"modelInfo": modelInfo,
 	})
 }
 
 /*
-This API retrieves model info list managed in modelmgmtservice
+This API retrieves model info list managed in modelmgmtservice. The list is filtered, sorted and
+paginated as requested, the total count and the links to the other pages are returned in headers.
+model-name and model-version together select a single registration, an artifact version or alias
+can be given along with them to get the registration as of that artifact.
 */
 func (m *MmeApiHandler) GetModelInfo(cont *gin.Context) {
 	logging.INFO("Get model info ")
-	queryParams := cont.Request.URL.Query()
-	// to check only modelName and modelVersion can be passed.
-	allowedParams := map[string]bool{
-		MODELNAME:    true,
-		MODELVERSION: true,
-	}
-
-	for key := range queryParams {
-		if !allowedParams[key] {
-			logging.ERROR("error:", "Only allowed params are modelname and modelversion")
-			cont.JSON(http.StatusBadRequest, models.ProblemDetail{
-				Status: http.StatusBadRequest,
-				Title:  "Bad Request",
-				Detail: fmt.Sprintf("Only allowed params are modelname and modelversion"),
+	for key := range cont.Request.URL.Query() {
+		if !slices.Contains(discoveryParams, key) {
+			logging.ERROR("error:", "Only allowed params are "+strings.Join(discoveryParams, ", "))
+			writeProblem(cont, models.ProblemDetail{
+				Status:        http.StatusBadRequest,
+				Title:         "Bad Request",
+				Detail:        "Only allowed params are " + strings.Join(discoveryParams, ", "),
+				InvalidParams: []models.InvalidParam{{Param: key, Reason: "is not a known parameter"}},
 			})
 			return
 		}
@@ -140,88 +140,114 @@ func (m *MmeApiHandler) GetModelInfo(cont *gin.Context) {
 
 	modelName := cont.Query(MODELNAME)
 	modelVersion := cont.Query(MODELVERSION)
+	artifactRef := cont.Query(ARTIFACTVERSION)
+	lifecycleState := cont.Query(LIFECYCLESTATE)
+
+	if lifecycleState != "" && !models.ValidLifecycleState(lifecycleState) {
+		writeProblem(cont, models.ProblemDetail{
+			Status: http.StatusBadRequest,
+			Title:  "Bad Request",
+			Detail: fmt.Sprintf("Unknown lifecycle-state: %s", lifecycleState),
+		})
+		return
+	}
+
+	if artifactRef != "" && (modelName == "" || modelVersion == "") {
+		writeProblem(cont, models.ProblemDetail{
+			Status: http.StatusBadRequest,
+			Title:  "Bad Request",
+			Detail: "artifact-version can only be given along with model-name and model-version",
+		})
+		return
+	}
 
-	if modelName == "" && modelVersion == "" {
-		// return all modelinfo stored
-		models, err := m.iDB.GetAll()
+	if modelName == "" || modelVersion == "" {
+		query, err := parseModelInfoQuery(cont)
 		if err != nil {
-			logging.ERROR("error:", err)
-			cont.JSON(http.StatusInternalServerError, gin.H{
-				"code":    http.StatusInternalServerError,
-				"message": err.Error(),
+			writeInvalidRequest(cont, err, err.Error())
+			return
+		}
+		modelInfos, total, err := m.iDB.Find(query)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			logging.ERROR("Error occurred, send status code: ", statusCode)
+			writeProblem(cont, models.ProblemDetail{
+				Status: http.StatusInternalServerError,
+				Title:  "Internal Server Error",
+				Detail: fmt.Sprintf("Can't fetch the models due to , %s", err.Error()),
 			})
 			return
 		}
-		cont.JSON(http.StatusOK, models)
+		setPageHeaders(cont, query.Limit, query.Offset, total)
+		cont.JSON(http.StatusOK, modelInfos)
 		return
-	} else {
-		if modelVersion == "" {
-			// get all modelInfo by model name
-			modelInfos, err := m.iDB.GetModelInfoByName(modelName)
-			if err != nil {
-				statusCode := http.StatusInternalServerError
-				logging.ERROR("Error occurred, send status code: ", statusCode)
-				cont.JSON(statusCode, models.ProblemDetail{
-					Status: http.StatusInternalServerError,
-					Title:  "Internal Server Error",
-					Detail: fmt.Sprintf("Can't fetch the models due to , %s", err.Error()),
-				})
-				return
-			}
-			cont.JSON(http.StatusOK, modelInfos)
-			return
-		} else {
-			// get all modelInfo by model name and version
-			modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
-			if err != nil {
-				statusCode := http.StatusInternalServerError
-				logging.ERROR("Error occurred, send status code: ", statusCode)
-				cont.JSON(statusCode, models.ProblemDetail{
-					Status: http.StatusInternalServerError,
-					Title:  "Internal Server Error",
-					Detail: fmt.Sprintf("Can't fetch all the models due to , %s", err.Error()),
-				})
-				return
-			}
-			if modelInfo.ModelId.ModelName != modelName && modelInfo.ModelId.ModelVersion != modelVersion {
-				statusCode := http.StatusNotFound
-				logging.ERROR("Record not found, send status code: ", statusCode)
-				cont.JSON(statusCode, models.ProblemDetail{
-					Status: http.StatusNotFound,
-					Title:  "Not Found",
-					Detail: fmt.Sprintf("Record not found with modelName: %s and modelVersion: %s", modelName, modelVersion),
-				})
-				return
-			}
-			response := []models.ModelRelatedInformation{*modelInfo}
-			cont.JSON(http.StatusOK, response)
-			return
+	}
+
+	// model-name and model-version together select a single registration
+	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
+	if errors.Is(err, db.ErrNotFound) {
+		statusCode := http.StatusNotFound
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Found",
+			Detail: fmt.Sprintf("Record not found with modelName: %s and modelVersion: %s", modelName, modelVersion),
+		})
+		return
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't fetch all the models due to , %s", err.Error()))
+		return
+	}
+	if artifactRef != "" && !m.pinArtifactVersion(cont, modelInfo, artifactRef) {
+		return
+	}
+	response := []models.ModelRelatedInformation{*modelInfo}
+	cont.JSON(http.StatusOK, filterByLifecycleState(response, lifecycleState))
+}
+
+// Keeps the registrations in the given lifecycle state, all of them when no state is given
+func filterByLifecycleState(modelInfos []models.ModelRelatedInformation, state string) []models.ModelRelatedInformation {
+	if state == "" {
+		return modelInfos
+	}
+	filtered := []models.ModelRelatedInformation{}
+	for _, modelInfo := range modelInfos {
+		if modelInfo.LifecycleState == state {
+			filtered = append(filtered, modelInfo)
 		}
 	}
+	return filtered
+}
+
+// Replaces the artifact version and checksum of the registration by the ones of the given artifact
+// version or alias, writes the error response and returns false when it can't
+func (m *MmeApiHandler) pinArtifactVersion(cont *gin.Context, modelInfo *models.ModelRelatedInformation, artifactRef string) bool {
+	artifactVersion, ok := m.resolveArtifactVersion(cont, modelInfo.ModelId.ModelName, modelInfo.ModelId.ModelVersion, artifactRef)
+	if !ok {
+		return false
+	}
+	artifact, err := m.iDB.GetArtifactVersion(modelInfo.Id, artifactVersion)
+	if errors.Is(err, db.ErrNotFound) {
+		artifactVersionNotFound(cont, modelInfo.Id, artifactVersion)
+		return false
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't fetch the artifact version due to , %s", err.Error()))
+		return false
+	}
+	modelInfo.ModelId.ArtifactVersion = artifact.Version
+	modelInfo.ArtifactChecksum = artifact.Digest
+	return true
 }
 
 func (m *MmeApiHandler) GetModelInfoById(cont *gin.Context) {
 	logging.INFO("Get model info by id ...")
 	id := cont.Param("modelRegistrationId")
-	modelInfo, err := m.iDB.GetModelInfoById(id)
-	if err != nil {
-		logging.ERROR("error:", err)
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": err.Error(),
-		})
-		return
-	}
-	if modelInfo.Id == "" {
-		statusCode := http.StatusNotFound
-		errMessage := fmt.Sprintf("Record not found with id: %s", id)
-		logging.ERROR("Record not found, send status code: ", statusCode)
-		cont.JSON(statusCode, gin.H{
-			"code":    statusCode,
-			"message": errMessage,
-		})
+	modelInfo, ok := m.getRegistration(cont, id)
+	if !ok {
 		return
 	}
+	cont.Header("ETag", etag(modelInfo.RowVersion))
 	cont.JSON(http.StatusOK, modelInfo)
 	return
 }
@@ -235,15 +261,18 @@ func (m *MmeApiHandler) GetModelInfoByName(cont *gin.Context) {
 
 	bucketObj, err := m.dbmgr.GetBucketObject(modelName+os.Getenv("INFO_FILE_POSTFIX"), modelName)
 	if err != nil {
-		logging.ERROR("Unable to GetModelInfoByName: Error ", err)
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": err.Error(),
-		})
+		writeError(cont, err, err.Error())
+		return
+	}
+	defer bucketObj.Close()
+	infoBytes, err := io.ReadAll(bucketObj)
+	if err != nil {
+		writeError(cont, err, err.Error())
+		return
 	}
 	modelInfoListResp := models.ModelInfoResponse{
 		Name: modelName,
-		Data: string(bucketObj),
+		Data: string(infoBytes),
 	}
 
 	cont.JSON(http.StatusOK, gin.H{
@@ -262,26 +291,8 @@ func (m *MmeApiHandler) UploadModel(cont *gin.Context) {
 	modelVersion := cont.Param("modelVersion")
 
 	// Confirm if Model with Given ModelId: (ModelName and ModelVersion) is Registered or not:
-	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
-	if err != nil {
-		statusCode := http.StatusInternalServerError
-		logging.ERROR("Error occurred while getting models: " + strconv.Itoa(statusCode))
-		cont.JSON(statusCode, models.ProblemDetail{
-			Status: statusCode,
-			Title:  "Internal Server Error",
-			Detail: fmt.Sprintf("Can't fetch model with modelName : %s & modelVersion : %s due to , %s", modelName, modelVersion, err.Error()),
-		})
-		return
-	}
-
-	if modelInfo.ModelId.ModelName != modelName && modelInfo.ModelId.ModelVersion != modelVersion {
-		statusCode := http.StatusNotFound
-		logging.ERROR("Record not found, send status code: " + strconv.Itoa(statusCode))
-		cont.JSON(statusCode, models.ProblemDetail{
-			Status: statusCode,
-			Title:  "Not Found",
-			Detail: fmt.Sprintf("ModelName: %s and modelVersion: %s is not registered, Kindly register it first!", modelName, modelVersion),
-		})
+	modelInfo, ok := m.getRegisteredModel(cont, modelName, modelVersion)
+	if !ok {
 		return
 	}
 
@@ -290,7 +301,7 @@ func (m *MmeApiHandler) UploadModel(cont *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		logging.ERROR("failed to read form file: %v", err)
-		cont.JSON(statusCode, models.ProblemDetail{
+		writeProblem(cont, models.ProblemDetail{
 			Status: statusCode,
 			Title:  "Internal Server Error",
 			Detail: fmt.Sprintf("Can't read form file| Error: %s", err.Error()),
@@ -302,7 +313,7 @@ func (m *MmeApiHandler) UploadModel(cont *gin.Context) {
 	if !strings.HasSuffix(strings.ToLower(fileHeader.Filename), ".zip") {
 		statusCode := http.StatusUnsupportedMediaType
 		logging.ERROR("invalid file type: %s", fileHeader.Filename)
-		cont.JSON(statusCode, models.ProblemDetail{
+		writeProblem(cont, models.ProblemDetail{
 			Status: statusCode,
 			Title:  "Unsupported Media Type",
 			Detail: fmt.Sprintf("invalid file type: %s, Only .zip files are allowed", fileHeader.Filename),
@@ -314,7 +325,7 @@ func (m *MmeApiHandler) UploadModel(cont *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		logging.ERROR("failed to open uploaded file: %s", err.Error())
-		cont.JSON(statusCode, models.ProblemDetail{
+		writeProblem(cont, models.ProblemDetail{
 			Status: statusCode,
 			Title:  "Internal Server Error",
 			Detail: fmt.Sprintf("failed to open uploaded file: %s", err.Error()),
@@ -323,104 +334,231 @@ func (m *MmeApiHandler) UploadModel(cont *gin.Context) {
 	}
 	defer file.Close()
 
-	byteFile, err := io.ReadAll(file)
-	if err != nil {
-		statusCode := http.StatusInternalServerError
-		logging.ERROR("Error reading file content: %s", err.Error())
-		cont.JSON(statusCode, models.ProblemDetail{
-			Status: statusCode,
-			Title:  "Internal Server Error",
-			Detail: fmt.Sprintf("Error reading file content: %s", err.Error()),
-		})
-		return
+	// Optional checksum supplied by the client, the upload is rejected when it doesn't match
+	expectedChecksum := cont.PostForm("checksum")
+	if expectedChecksum != "" {
+		expectedChecksum, err = utils.NormalizeChecksum(expectedChecksum)
+		if err != nil {
+			statusCode := http.StatusBadRequest
+			logging.ERROR("invalid checksum: %s", err.Error())
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Bad Request",
+				Detail: err.Error(),
+			})
+			return
+		}
 	}
 
-	artifactVersion := modelInfo.ModelId.ArtifactVersion
-	// Update the Artifact-Version
-	newArtifactVersion, err := utils.IncrementArtifactVersion(artifactVersion)
+	// Concurrent uploads are given distinct artifact versions, the caller may pick the bump level
+	// (major, minor, patch) or an explicit version with the optional artifactVersion field
+	newArtifactVersion, err := m.iDB.AllocateArtifactVersion(modelName, modelVersion, cont.PostForm("artifactVersion"))
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		logging.ERROR("Unable to get newArtifactVersion: %s", err.Error())
-		cont.JSON(statusCode, models.ProblemDetail{
-			Status: statusCode,
-			Title:  "Internal Server Error",
-			Detail: fmt.Sprintf("Unable to get newArtifactVersion: %s", err.Error()),
-		})
+		writeError(cont, err, fmt.Sprintf("Unable to get newArtifactVersion: %s", err.Error()))
 		return
 	}
 	modelKey := fmt.Sprintf("%s_%s_%s", modelName, modelVersion, newArtifactVersion)
-	exportBucket := strings.ToLower(modelName)
+	exportBucket := utils.ModelBucketName(modelName)
+
+	/*
+		The artifact is first written under a staging name, then moved to its final name while the
+		registration row is locked and the new artifact version is recorded in the same transaction.
+		A failure at any step leaves the registration on the previous artifact version, the
+		staging object being the only leftover, and the registration never points at a missing object.
+		An upload completing after a more recent one is kept as a previous artifact version.
+	*/
+	logging.INFO("Uploading model : " + modelKey)
+	fileName := modelKey + os.Getenv("MODEL_FILE_POSTFIX")
+	stagingName := utils.StagingObjectName(fileName, uuid.NewString())
+	// The multipart file is streamed to the storage, it is never read into memory as a whole,
+	// the checksum is computed on the way
+	checksumReader := utils.NewChecksumReader(file)
+	if err := m.dbmgr.UploadFile(checksumReader, stagingName, exportBucket); err != nil {
+		writeError(cont, err, fmt.Sprintf("Failed to Upload Model : %s, artifact-version %s is not committed", err.Error(), newArtifactVersion))
+		return
+	}
 
-	modelInfo.ModelId.ArtifactVersion = newArtifactVersion
-	if err := m.iDB.Update(*modelInfo); err != nil {
-		statusCode := http.StatusInternalServerError
-		logging.ERROR("Unable to update newArtifactVersion: %s", err.Error())
-		cont.JSON(statusCode, models.ProblemDetail{
+	checksum := checksumReader.Checksum()
+	if expectedChecksum != "" && expectedChecksum != checksum {
+		logging.ERROR(fmt.Sprintf("Checksum mismatch for model : %s, artifact-version %s is not committed", modelKey, newArtifactVersion))
+		m.dbmgr.DeleteBucketObject(stagingName, exportBucket)
+
+		statusCode := http.StatusBadRequest
+		writeProblem(cont, models.ProblemDetail{
 			Status: statusCode,
-			Title:  "Internal Server Error",
-			Detail: fmt.Sprintf("Unable to update newArtifactVersion: %s", err.Error()),
+			Title:  "Bad Request",
+			Detail: fmt.Sprintf("checksum mismatch: expected %s, got %s", expectedChecksum, checksum),
 		})
 		return
 	}
 
-	// Upload the file to s3-bucket
-	logging.INFO("Uploading model : " + modelKey)
-	if err := m.dbmgr.UploadFile(byteFile, modelKey+os.Getenv("MODEL_FILE_POSTFIX"), exportBucket); err != nil {
-		// Model failed to update: Rollback artifact version to old-one
-		logging.ERROR(fmt.Sprintf("Failed to Upload Model : %s, Rolling back to previous artifact-version : %s", err.Error(), artifactVersion))
-		modelInfo.ModelId.ArtifactVersion = artifactVersion
-		if err := m.iDB.Update(*modelInfo); err != nil {
-			/*
-				Ideally, the following situation should never occur.
-				This scenario can happen when:
-				The model artifact version is incremented to a new version, and
-				The file upload to the bucket fails, and
-				The rollback to the previous artifact version also fails.
-			*/
-			logging.ERROR("Unable to rollback to old-artifactVersion: %s", err.Error())
+	artifact := models.ArtifactVersion{
+		Version:    newArtifactVersion,
+		ObjectName: fileName,
+		Size:       fileHeader.Size,
+		Digest:     checksum,
+		UploadedBy: requestActor(cont),
+	}
+	committed, err := m.auditedDB(cont).CommitArtifactVersion(modelName, modelVersion, artifact, func() error {
+		return m.dbmgr.MoveBucketObject(stagingName, fileName, exportBucket)
+	})
+	if err != nil {
+		// When only the commit failed the staging object is already gone, the moved
+		// artifact is then reported as uncommitted by the consistency check
+		m.dbmgr.DeleteBucketObject(stagingName, exportBucket)
+		if errors.Is(err, db.ErrArtifactVersionConflict) {
+			statusCode := http.StatusConflict
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Conflict",
+				Detail: fmt.Sprintf("artifactVersion %s of modelName: %s and modelVersion: %s can't be committed", newArtifactVersion, modelName, modelVersion),
+			})
+			return
 		}
-
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": err.Error(),
-		})
+		writeError(cont, err, fmt.Sprintf("Unable to commit artifact : %s, artifact-version %s is not committed", err.Error(), newArtifactVersion))
 		return
 	}
+	modelInfo = committed
 
 	logging.INFO("model updated")
 	cont.JSON(http.StatusOK, gin.H{
-		"code":      http.StatusOK,
-		"message":   string("Model uploaded successfully.."),
-		"modelinfo": modelInfo,
+		"code":            http.StatusOK,
+		"message":         string("Model uploaded successfully.."),
+		"modelinfo":       modelInfo,
+		"checksum":        checksum,
+		"artifactVersion": newArtifactVersion,
 	})
 }
 
 /*
 API to download the trained model from  bucket
 Input: model name in path params as "modelName"
+Supports HEAD, single byte Range requests (If-Range) and If-None-Match
 */
 func (m *MmeApiHandler) DownloadModel(cont *gin.Context) {
 	logging.INFO("Download model API ...")
 	modelName := cont.Param("modelName")
 	modelVersion := cont.Param("modelVersion")
-	artifactVersion := cont.Param("artifactVersion")
+	// an alias, such as latest or production, can be given in place of the artifact version
+	artifactVersion, ok := m.resolveArtifactVersion(cont, modelName, modelVersion, cont.Param("artifactVersion"))
+	if !ok || !m.checkArtifactNotRevoked(cont, modelName, modelVersion, artifactVersion) {
+		return
+	}
 
 	modelKey := fmt.Sprintf("%s_%s_%s", modelName, modelVersion, artifactVersion)
-	exportBucket := strings.ToLower(modelName)
+	exportBucket := utils.ModelBucketName(modelName)
 
 	fileName := modelKey + os.Getenv("MODEL_FILE_POSTFIX")
-	fileByes, err := m.dbmgr.GetBucketObject(fileName, exportBucket)
+	objectInfo, err := m.dbmgr.HeadBucketObject(fileName, exportBucket)
 	if err != nil {
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": err.Error(),
-		})
+		if errors.Is(err, core.ErrObjectNotFound) {
+			statusCode := http.StatusNotFound
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Not Found",
+				Detail: fmt.Sprintf("Model artifact not found with modelName: %s, modelVersion: %s and artifactVersion: %s", modelName, modelVersion, artifactVersion),
+			})
+			return
+		}
+		writeError(cont, err, err.Error())
+		return
+	}
+
+	// The checksum is recorded in the artifact history, the artifacts without a history entry,
+	// stored by the earlier releases, get a weak validator built from their size and modification time
+	checksum := m.getArtifactChecksum(modelName, modelVersion, artifactVersion)
+	etag := fmt.Sprintf(`W/"%x-%x"`, objectInfo.Size, objectInfo.LastModified.Unix())
+	if checksum != "" {
+		etag = `"` + checksum + `"`
+		cont.Header("Digest", utils.DigestHeader(checksum))
+	}
+	cont.Header("ETag", etag)
+	cont.Header("Accept-Ranges", "bytes")
+	if !objectInfo.LastModified.IsZero() {
+		cont.Header("Last-Modified", objectInfo.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if utils.ETagMatches(cont.GetHeader("If-None-Match"), etag) {
+		cont.Status(http.StatusNotModified)
 		return
 	}
-	// Return file in api response using byte slice
+
+	var byteRange *utils.ByteRange
+	if utils.IfRangeMatches(cont.GetHeader("If-Range"), etag, objectInfo.LastModified) {
+		byteRange, err = utils.ParseByteRange(cont.GetHeader("Range"), objectInfo.Size)
+		if err != nil {
+			statusCode := http.StatusRequestedRangeNotSatisfiable
+			cont.Header("Content-Range", fmt.Sprintf("bytes */%d", objectInfo.Size))
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Range Not Satisfiable",
+				Detail: fmt.Sprintf("%s for artifact of %d bytes", cont.GetHeader("Range"), objectInfo.Size),
+			})
+			return
+		}
+	}
+
+	statusCode := http.StatusOK
+	contentLength := objectInfo.Size
+	if byteRange != nil {
+		statusCode = http.StatusPartialContent
+		contentLength = byteRange.Length
+		cont.Header("Content-Range", byteRange.ContentRange(objectInfo.Size))
+	}
 	cont.Header("Content-Disposition", "attachment;"+fileName)
-	cont.Header("Content-Type", "application/zip")
-	cont.Data(http.StatusOK, "application/octet", fileByes)
+
+	if cont.Request.Method == http.MethodHead {
+		cont.Header("Content-Type", "application/zip")
+		cont.Header("Content-Length", strconv.FormatInt(contentLength, 10))
+		cont.Status(statusCode)
+		return
+	}
+
+	var fileReader *core.BucketObjectReader
+	if byteRange != nil {
+		fileReader, err = m.dbmgr.GetBucketObjectRange(fileName, exportBucket, byteRange.Start, byteRange.Length)
+	} else {
+		fileReader, err = m.dbmgr.GetBucketObject(fileName, exportBucket)
+	}
+	if err != nil {
+		writeError(cont, err, err.Error())
+		return
+	}
+	defer fileReader.Close()
+
+	var body io.Reader = fileReader
+	if checksum != "" && byteRange == nil {
+		// A corrupted artifact stops short of its last byte
+		body = utils.NewVerifyingReader(fileReader, checksum)
+	}
+
+	// Stream the file from the storage into the api response
+	cont.DataFromReader(statusCode, contentLength, "application/zip", body, nil)
+	if len(cont.Errors) > 0 {
+		logging.ERROR("Failed to download model : "+fileName, "error", cont.Errors.Last())
+		if errors.Is(cont.Errors.Last(), utils.ErrChecksumMismatch) {
+			// the status is sent already, cutting the connection tells the client the transfer failed
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// Returns the recorded checksum of the given artifact, or empty when it is unknown
+func (m *MmeApiHandler) getArtifactChecksum(modelName string, modelVersion string, artifactVersion string) string {
+	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
+	if err != nil {
+		logging.WARN("Unable to fetch model info for checksum", "error", err)
+		return ""
+	}
+	if modelInfo.ModelId.ArtifactVersion == artifactVersion {
+		return modelInfo.ArtifactChecksum
+	}
+	// previous artifact versions are found in the history
+	artifact, err := m.iDB.GetArtifactVersion(modelInfo.Id, artifactVersion)
+	if err != nil {
+		return ""
+	}
+	return artifact.Digest
 }
 
 func (m *MmeApiHandler) GetModel(cont *gin.Context) {
@@ -434,57 +572,155 @@ func (m *MmeApiHandler) UpdateModel(c *gin.Context) {
 	var modelInfo models.ModelRelatedInformation
 
 	if err := c.ShouldBindJSON(&modelInfo); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		writeInvalidRequest(c, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
 		return
 	}
-	existingModelInfo, err := m.iDB.GetModelInfoById(id)
-
-	if err != nil || existingModelInfo.Id == "" {
-		statusCode := http.StatusNotFound
-		logging.ERROR("Error occurred, send status code: ", statusCode)
-		c.JSON(statusCode, gin.H{
-			"code":    statusCode,
-			"message": fmt.Sprintf("model not found with id: %s", id),
-		})
+	existingModelInfo, ok := m.getRegistration(c, id)
+	if !ok {
 		return
 	}
 
 	if existingModelInfo.ModelId.ModelName != modelInfo.ModelId.ModelName || existingModelInfo.ModelId.ModelVersion != modelInfo.ModelId.ModelVersion {
 		statusCode := http.StatusBadRequest
-		logging.ERROR("Error occurred, send status code: ", statusCode)
-		c.JSON(statusCode, gin.H{
-			"code":    statusCode,
-			"message": fmt.Sprintf("model with id: %s has different modelName and modelVersion than provided", id),
+		writeProblem(c, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Bad Request",
+			Detail: fmt.Sprintf("model with id: %s has different modelName and modelVersion than provided", id),
 		})
 		return
 	}
 
+	rowVersion, conditional, ok := ifMatchVersion(c)
+	if !ok || (conditional && rowVersion != existingModelInfo.RowVersion) {
+		preconditionFailed(c, id)
+		return
+	}
+
 	modelInfo.Id = id
-	if err := m.iDB.Update(modelInfo); err != nil {
-		logging.ERROR("error in update db", "Error:", err)
+	modelInfo.RowVersion = existingModelInfo.RowVersion
+	// the artifact is only changed by uploads
+	modelInfo.ModelId.ArtifactVersion = existingModelInfo.ModelId.ArtifactVersion
+	modelInfo.ArtifactChecksum = existingModelInfo.ArtifactChecksum
+	if err := m.auditedDB(c).Update(modelInfo); err != nil {
+		if errors.Is(err, db.ErrStale) {
+			staleWrite(c, id, conditional)
+			return
+		}
+		writeError(c, err, err.Error())
 		return
 	}
 
 	logging.INFO("model updated")
+	c.Header("ETag", etag(modelInfo.RowVersion+1))
 	c.JSON(http.StatusOK, gin.H{
 		"modelinfo": modelInfo,
 	})
 }
 
+/*
+Deletes the registration of a model. The registration is only marked as deleted and can be
+restored until it is purged, after the MODEL_DELETE_RETENTION. The stored artifacts are handled
+according to the MODEL_DELETE_POLICY: refuse keeps the registration while artifacts exist,
+cascade removes them when the registration is purged and orphan leaves them in the storage.
+*/
 func (m *MmeApiHandler) DeleteModel(cont *gin.Context) {
 	id := cont.Param("modelRegistrationId")
 	logging.INFO("Deleting model... id = ", id)
-	_, err := m.iDB.Delete(id)
+
+	modelInfo, ok := m.getRegistration(cont, id)
+	if !ok {
+		return
+	}
+	rowVersion, conditional, ok := ifMatchVersion(cont)
+	if !ok || (conditional && rowVersion != modelInfo.RowVersion) {
+		preconditionFailed(cont, id)
+		return
+	}
+
+	if modelDeletePolicy() == config.DELETE_POLICY_REFUSE {
+		artifacts, err := m.listModelArtifacts(modelInfo)
+		if err != nil {
+			writeError(cont, err, fmt.Sprintf("Can't list the artifacts due to , %s", err.Error()))
+			return
+		}
+		if len(artifacts) > 0 {
+			statusCode := http.StatusConflict
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Conflict",
+				Detail: fmt.Sprintf("model with id: %s still has %d stored artifacts", id, len(artifacts)),
+			})
+			return
+		}
+	}
+
+	rows, err := m.auditedDB(cont).Delete(id, requestActor(cont), modelInfo.RowVersion)
+	if errors.Is(err, db.ErrStale) {
+		staleWrite(cont, id, conditional)
+		return
+	}
 	if err != nil {
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		writeError(cont, err, err.Error())
 		return
 	}
+	if rows == 0 {
+		modelNotFound(cont, id)
+		return
+	}
+	cont.Status(http.StatusNoContent)
+}
 
-	cont.JSON(http.StatusNoContent, nil)
+func modelDeletePolicy() string {
+	switch policy := os.Getenv("MODEL_DELETE_POLICY"); policy {
+	case config.DELETE_POLICY_REFUSE, config.DELETE_POLICY_CASCADE:
+		return policy
+	default:
+		return config.DELETE_POLICY_ORPHAN
+	}
+}
+
+func modelNotFound(cont *gin.Context, id string) {
+	statusCode := http.StatusNotFound
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Not Found",
+		Detail: fmt.Sprintf("model not found with id: %s", id),
+	})
+}
+
+// Removes the artifacts from the storage, the bucket is shared by every version of
+// the model so it is only removed along with the last artifact when nothing else is left
+func (m *MmeApiHandler) removeModelArtifacts(modelInfo *models.ModelRelatedInformation, artifacts []models.ArtifactInfo, report *models.DeleteModelReport) {
+	bucketName := utils.ModelBucketName(modelInfo.ModelId.ModelName)
+	removeBucket := false
+	if items, err := m.dbmgr.GetBucketItems(bucketName); err != nil {
+		logging.WARN("Unable to list the bucket, it is kept", "error", err)
+	} else {
+		removeBucket = len(items) == len(artifacts)
+	}
+
+	last := len(artifacts) - 1
+	for i, artifact := range artifacts {
+		if i == last && removeBucket {
+			m.dbmgr.DeleteBucket(artifact.ObjectName, bucketName)
+			// DeleteBucket doesn't report its outcome, check the object is gone
+			if _, err := m.dbmgr.HeadBucketObject(artifact.ObjectName, bucketName); errors.Is(err, core.ErrObjectNotFound) {
+				report.RemovedObjects = append(report.RemovedObjects, artifact.ObjectName)
+				report.BucketRemoved = true
+			} else {
+				report.FailedObjects = append(report.FailedObjects, artifact.ObjectName)
+			}
+			continue
+		}
+		if m.dbmgr.DeleteBucketObject(artifact.ObjectName, bucketName) {
+			report.RemovedObjects = append(report.RemovedObjects, artifact.ObjectName)
+		} else {
+			report.FailedObjects = append(report.FailedObjects, artifact.ObjectName)
+		}
+	}
+	if len(report.FailedObjects) > 0 {
+		logging.WARN("Some artifacts could not be removed", "objects", report.FailedObjects)
+	}
 }
 
 // Deprecated: use the new API reference: UploadModel.
@@ -493,22 +729,42 @@ func (m *MmeApiHandler) UpdateArtifact(cont *gin.Context) {
 	modelname := cont.Param("modelname")
 	modelversion := cont.Param("modelversion")
 	artifactversion := cont.Param("artifactversion")
-	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelname, modelversion)
-	if err != nil {
-		logging.ERROR("error:", err)
-		cont.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": err.Error(),
-		})
+	modelInfo, ok := m.getRegisteredModel(cont, modelname, modelversion)
+	if !ok {
 		return
 	}
-	modelInfo.ModelId.ArtifactVersion = artifactversion
-	if err := m.iDB.Update(*modelInfo); err != nil {
-		logging.ERROR("error in update db", "error:", err)
+	// keep the checksum when the version was committed through the new API
+	checksum := ""
+	if artifact, err := m.iDB.GetArtifactVersion(modelInfo.Id, artifactversion); err == nil {
+		checksum = artifact.Digest
+	}
+	if err := m.auditedDB(cont).SetCurrentArtifactVersion(modelname, modelversion, artifactversion, checksum, modelInfo.RowVersion); err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't update the artifact version: %s", err.Error()))
 		return
 	}
+	modelInfo.ModelId.ArtifactVersion = artifactversion
+	modelInfo.ArtifactChecksum = checksum
 	logging.INFO("model updated")
 	cont.JSON(http.StatusOK, gin.H{
 		"modelinfo": modelInfo,
 	})
 }
+
+// Fetches the registration of the model, writes the error response and returns false when it can't
+func (m *MmeApiHandler) getRegisteredModel(cont *gin.Context, modelName string, modelVersion string) (*models.ModelRelatedInformation, bool) {
+	modelInfo, err := m.iDB.GetModelInfoByNameAndVer(modelName, modelVersion)
+	if errors.Is(err, db.ErrNotFound) {
+		statusCode := http.StatusNotFound
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Found",
+			Detail: fmt.Sprintf("ModelName: %s and modelVersion: %s is not registered, Kindly register it first!", modelName, modelVersion),
+		})
+		return nil, false
+	}
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't fetch model with modelName : %s & modelVersion : %s due to , %s", modelName, modelVersion, err.Error()))
+		return nil, false
+	}
+	return modelInfo, true
+}
@@ -0,0 +1,174 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	LIMIT           = "limit"
+	OFFSET          = "offset"
+	SORT            = "sort"
+	SEARCHTEXT      = "q"
+	INCLUDEDELETED  = "include-deleted"
+	AUTHOR          = "author"
+	OWNER           = "owner"
+	INPUTDATATYPE   = "input-data-type"
+	OUTPUTDATATYPE  = "output-data-type"
+	PLATFORMNAME    = "platform-name"
+	ENVIRONMENTTYPE = "environment-type"
+
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+// Query parameters accepted by the model discovery
+var discoveryParams = []string{
+	MODELNAME, MODELVERSION, ARTIFACTVERSION, LIFECYCLESTATE,
+	AUTHOR, OWNER, INPUTDATATYPE, OUTPUTDATATYPE, PLATFORMNAME, ENVIRONMENTTYPE,
+	INCLUDEDELETED, SORT, LIMIT, OFFSET,
+}
+
+var sortFields = []string{models.SortModelName, models.SortModelVersion, models.SortCreatedAt, models.SortArtifactVersion}
+
+// Error of a query parameter, the parameter is reported as an invalid param of the problem
+type queryParamError struct {
+	param   string
+	message string
+}
+
+func (e *queryParamError) Error() string {
+	return e.message
+}
+
+func queryInt(cont *gin.Context, key string, defaultValue int, min int, max int) (int, error) {
+	value := cont.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, &queryParamError{key, fmt.Sprintf("%s must be an integer between %d and %d", key, min, max)}
+	}
+	return n, nil
+}
+
+// Builds the discovery query from the request, sort is a comma separated list of fields,
+// each one prefixed by '-' for a descending order
+func parseModelInfoQuery(cont *gin.Context) (models.ModelInfoQuery, error) {
+	query := models.ModelInfoQuery{
+		ModelName:       cont.Query(MODELNAME),
+		ModelVersion:    cont.Query(MODELVERSION),
+		LifecycleState:  cont.Query(LIFECYCLESTATE),
+		Author:          cont.Query(AUTHOR),
+		Owner:           cont.Query(OWNER),
+		InputDataType:   cont.Query(INPUTDATATYPE),
+		OutputDataType:  cont.Query(OUTPUTDATATYPE),
+		PlatformName:    cont.Query(PLATFORMNAME),
+		EnvironmentType: cont.Query(ENVIRONMENTTYPE),
+	}
+
+	var err error
+	if query.Limit, err = queryInt(cont, LIMIT, defaultPageLimit, 1, maxPageLimit); err != nil {
+		return query, err
+	}
+	if query.Offset, err = queryInt(cont, OFFSET, 0, 0, int(^uint(0)>>1)); err != nil {
+		return query, err
+	}
+	if value := cont.Query(INCLUDEDELETED); value != "" {
+		if query.IncludeDeleted, err = strconv.ParseBool(value); err != nil {
+			return query, &queryParamError{INCLUDEDELETED, fmt.Sprintf("%s must be true or false", INCLUDEDELETED)}
+		}
+	}
+
+	if sort := cont.Query(SORT); sort != "" {
+		for _, field := range strings.Split(sort, ",") {
+			sortField := models.SortField{Field: strings.TrimSpace(field)}
+			if strings.HasPrefix(sortField.Field, "-") {
+				sortField.Field = sortField.Field[1:]
+				sortField.Desc = true
+			}
+			if !slices.Contains(sortFields, sortField.Field) {
+				return query, &queryParamError{SORT, fmt.Sprintf("unknown sort field %q, allowed fields are %s", sortField.Field, strings.Join(sortFields, ", "))}
+			}
+			query.Sort = append(query.Sort, sortField)
+		}
+	}
+	return query, nil
+}
+
+func pageLink(requestUrl url.URL, limit int, offset int, rel string) string {
+	params := requestUrl.Query()
+	params.Set(LIMIT, strconv.Itoa(limit))
+	params.Set(OFFSET, strconv.Itoa(offset))
+	requestUrl.RawQuery = params.Encode()
+	return fmt.Sprintf("<%s>; rel=\"%s\"", requestUrl.RequestURI(), rel)
+}
+
+// Sets the X-Total-Count header and the Link header (RFC 8288) to the first, previous, next and last pages
+func setPageHeaders(cont *gin.Context, limit int, offset int, total int64) {
+	cont.Header("X-Total-Count", strconv.FormatInt(total, 10))
+
+	requestUrl := *cont.Request.URL
+	links := []string{pageLink(requestUrl, limit, 0, "first")}
+	if offset > 0 {
+		links = append(links, pageLink(requestUrl, limit, max(offset-limit, 0), "prev"))
+	}
+	if int64(offset+limit) < total {
+		links = append(links, pageLink(requestUrl, limit, offset+limit, "next"))
+	}
+	if total > 0 {
+		links = append(links, pageLink(requestUrl, limit, int((total-1)/int64(limit))*limit, "last"))
+	}
+	cont.Header("Link", strings.Join(links, ", "))
+}
+
+// SearchModels returns the registrations matching a free text, most relevant first,
+// with the matched text highlighted
+func (m *MmeApiHandler) SearchModels(cont *gin.Context) {
+	logging.INFO("Searching models")
+	query := models.SearchQuery{Text: strings.TrimSpace(cont.Query(SEARCHTEXT))}
+	var err error
+	if query.Text == "" {
+		err = &queryParamError{SEARCHTEXT, fmt.Sprintf("%s is required", SEARCHTEXT)}
+	} else if query.Limit, err = queryInt(cont, LIMIT, defaultPageLimit, 1, maxPageLimit); err == nil {
+		query.Offset, err = queryInt(cont, OFFSET, 0, 0, int(^uint(0)>>1))
+	}
+	if err != nil {
+		writeInvalidRequest(cont, err, err.Error())
+		return
+	}
+
+	results, total, err := m.iDB.Search(query)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Can't search the models due to , %s", err.Error()))
+		return
+	}
+	setPageHeaders(cont, query.Limit, query.Offset, total)
+	cont.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,189 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+func invalidPatch(cont *gin.Context, detail string) {
+	statusCode := http.StatusBadRequest
+	writeProblem(cont, models.ProblemDetail{
+		Status: statusCode,
+		Title:  "Bad Request",
+		Detail: detail,
+	})
+}
+
+/*
+Returns the registration as the document the patches apply to. The target environments are
+always present, as an empty list when there is none, so a JSON patch can append to them.
+*/
+func registrationDocument(modelInfo *models.ModelRelatedInformation) ([]byte, error) {
+	document := *modelInfo
+	if document.ModelInformation.TargetEnvironment == nil {
+		document.ModelInformation.TargetEnvironment = []models.TargetEnvironment{}
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil || len(document.ModelInformation.TargetEnvironment) > 0 {
+		return encoded, err
+	}
+	// omitempty drops the empty list
+	var fields map[string]interface{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	fields["modelInformation"].(map[string]interface{})["targetEnvironment"] = []interface{}{}
+	return json.Marshal(fields)
+}
+
+// Lists the fields a patch changed although they are only maintained by the service
+func readOnlyFieldsChanged(before *models.ModelRelatedInformation, after *models.ModelRelatedInformation) []string {
+	fields := []struct {
+		name string
+		same bool
+	}{
+		{"id", before.Id == after.Id},
+		{"modelId/modelName", before.ModelId.ModelName == after.ModelId.ModelName},
+		{"modelId/modelVersion", before.ModelId.ModelVersion == after.ModelId.ModelVersion},
+		{"modelId/artifactVersion", before.ModelId.ArtifactVersion == after.ModelId.ArtifactVersion},
+		{"modelLocation", before.ModelLocation == after.ModelLocation},
+		{"artifactChecksum", before.ArtifactChecksum == after.ArtifactChecksum},
+		{"lifecycleState", before.LifecycleState == after.LifecycleState},
+		{"createdAt", before.CreatedAt.Equal(after.CreatedAt)},
+		{"deletedAt", before.DeletedAt.Valid == after.DeletedAt.Valid && before.DeletedAt.Time.Equal(after.DeletedAt.Time)},
+		{"deletedBy", before.DeletedBy == after.DeletedBy},
+	}
+	changed := []string{}
+	for _, field := range fields {
+		if !field.same {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
+
+/*
+PatchModel partially updates a registration. The body is either a JSON merge patch (RFC 7386) or
+a JSON patch (RFC 6902), as told by its content type, applied to the registration as returned by
+GetModelInfoById. The patched registration is validated as a new one would be, the fields only
+maintained by the service can't be changed.
+
+The target environments are replaced as a whole by the list found in the patched registration,
+leaving them out of a merge patch keeps them while setting them to null or [] removes them all.
+*/
+func (m *MmeApiHandler) PatchModel(cont *gin.Context) {
+	id := cont.Param("modelRegistrationId")
+	logging.INFO("Patching model... id = ", id)
+
+	applyPatch := utils.ApplyMergePatch
+	switch cont.ContentType() {
+	case mergePatchContentType:
+	case jsonPatchContentType:
+		applyPatch = utils.ApplyJSONPatch
+	default:
+		statusCode := http.StatusUnsupportedMediaType
+		cont.Header("Accept-Patch", mergePatchContentType+", "+jsonPatchContentType)
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Unsupported Media Type",
+			Detail: fmt.Sprintf("the patch must be sent as %s or %s", mergePatchContentType, jsonPatchContentType),
+		})
+		return
+	}
+	patch, err := io.ReadAll(cont.Request.Body)
+	if err != nil {
+		invalidPatch(cont, fmt.Sprintf("unable to read the patch, %s", err.Error()))
+		return
+	}
+
+	existingModelInfo, ok := m.getRegistration(cont, id)
+	if !ok {
+		return
+	}
+	rowVersion, conditional, ok := ifMatchVersion(cont)
+	if !ok || (conditional && rowVersion != existingModelInfo.RowVersion) {
+		preconditionFailed(cont, id)
+		return
+	}
+
+	document, err := registrationDocument(existingModelInfo)
+	if err == nil {
+		document, err = applyPatch(document, patch)
+	}
+	if err != nil {
+		invalidPatch(cont, fmt.Sprintf("The patch can't be applied, %s", err.Error()))
+		return
+	}
+	var modelInfo models.ModelRelatedInformation
+	if err := json.Unmarshal(document, &modelInfo); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The patched registration is not correct, %s", err.Error()))
+		return
+	}
+	if changed := readOnlyFieldsChanged(existingModelInfo, &modelInfo); len(changed) > 0 {
+		params := make([]models.InvalidParam, 0, len(changed))
+		for _, field := range changed {
+			params = append(params, models.InvalidParam{Param: "/" + field, Reason: "is read-only"})
+		}
+		writeProblem(cont, models.ProblemDetail{
+			Status:        http.StatusBadRequest,
+			Title:         "Bad Request",
+			Detail:        fmt.Sprintf("The patch changes read-only fields: %s", strings.Join(changed, ", ")),
+			InvalidParams: params,
+		})
+		return
+	}
+	if err := requestValidator.Struct(modelInfo); err != nil {
+		writeInvalidRequest(cont, err, fmt.Sprintf("The patched registration can't be validated, %s", err.Error()))
+		return
+	}
+	if modelInfo.ModelInformation.TargetEnvironment == nil {
+		// an absent list stands for no target environment, not for the stored ones
+		modelInfo.ModelInformation.TargetEnvironment = []models.TargetEnvironment{}
+	}
+
+	modelInfo.RowVersion = existingModelInfo.RowVersion
+	if err := m.auditedDB(cont).Update(modelInfo); err != nil {
+		if errors.Is(err, db.ErrStale) {
+			staleWrite(cont, id, conditional)
+			return
+		}
+		writeError(cont, err, err.Error())
+		return
+	}
+
+	logging.INFO("model patched")
+	cont.Header("ETag", etag(modelInfo.RowVersion+1))
+	cont.JSON(http.StatusOK, modelInfo)
+}
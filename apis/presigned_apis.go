@@ -0,0 +1,273 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultPresignedUrlTTL = 15 * time.Minute
+
+func presignedUrlTTL() time.Duration {
+	if ttl, err := time.ParseDuration(os.Getenv("PRESIGNED_URL_TTL")); err == nil && ttl > 0 {
+		return ttl
+	}
+	return defaultPresignedUrlTTL
+}
+
+// Writes the error response of a failed presign call
+func presignFailed(cont *gin.Context, err error) {
+	if errors.Is(err, core.ErrNotSupported) {
+		statusCode := http.StatusNotImplemented
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Not Implemented",
+			Detail: "Presigned URLs are not supported by the configured storage backend",
+		})
+		return
+	}
+	writeError(cont, err, fmt.Sprintf("Unable to presign url: %s", err.Error()))
+}
+
+/*
+The following API hands out a presigned URL to upload the next artifact of a registered model
+directly to the storage, the artifact only becomes the current one after CommitUpload is called.
+*/
+func (m *MmeApiHandler) PresignUpload(cont *gin.Context) {
+	logging.INFO("Presign upload API ...")
+	modelName := cont.Param("modelName")
+	modelVersion := cont.Param("modelVersion")
+
+	var request models.PresignedUrlRequest
+	if cont.Request.ContentLength != 0 {
+		if err := cont.ShouldBindJSON(&request); err != nil {
+			writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
+			return
+		}
+	}
+	checksum := ""
+	if request.Checksum != "" {
+		var err error
+		if checksum, err = utils.NormalizeChecksum(request.Checksum); err != nil {
+			statusCode := http.StatusBadRequest
+			writeProblem(cont, models.ProblemDetail{
+				Status:        statusCode,
+				Title:         "Bad Request",
+				Detail:        err.Error(),
+				InvalidParams: []models.InvalidParam{{Param: "/checksum", Reason: err.Error()}},
+			})
+			return
+		}
+	}
+
+	modelInfo, ok := m.getRegisteredModel(cont, modelName, modelVersion)
+	if !ok {
+		return
+	}
+	// an artifact version allocated for nothing would be skipped by the next uploads
+	if !m.dbmgr.SupportsPresign() {
+		presignFailed(cont, core.ErrNotSupported)
+		return
+	}
+
+	newArtifactVersion, err := m.iDB.AllocateArtifactVersion(modelInfo.ModelId.ModelName, modelInfo.ModelId.ModelVersion, request.ArtifactVersion)
+	if err != nil {
+		writeError(cont, err, fmt.Sprintf("Unable to get newArtifactVersion: %s", err.Error()))
+		return
+	}
+	modelKey := fmt.Sprintf("%s_%s_%s", modelName, modelVersion, newArtifactVersion)
+	exportBucket := utils.ModelBucketName(modelName)
+
+	ttl := presignedUrlTTL()
+	url, err := m.dbmgr.PresignUpload(modelKey+os.Getenv("MODEL_FILE_POSTFIX"), exportBucket, checksum, ttl)
+	if err != nil {
+		presignFailed(cont, err)
+		return
+	}
+
+	response := models.PresignedUrlResponse{
+		Url:             url,
+		Method:          http.MethodPut,
+		ArtifactVersion: newArtifactVersion,
+		ExpiresAt:       time.Now().Add(ttl).UTC(),
+	}
+	if checksum != "" {
+		response.Headers = map[string]string{
+			"x-amz-checksum-sha256": strings.TrimPrefix(utils.DigestHeader(checksum), "sha-256="),
+		}
+	}
+	cont.JSON(http.StatusOK, response)
+}
+
+/*
+The following API finalizes an artifact uploaded through a presigned URL, the artifact
+version is recorded in the registration once the object is present in the storage.
+*/
+func (m *MmeApiHandler) CommitUpload(cont *gin.Context) {
+	logging.INFO("Commit upload API ...")
+	modelName := cont.Param("modelName")
+	modelVersion := cont.Param("modelVersion")
+	artifactVersion := cont.Param("artifactVersion")
+
+	var request models.CommitUploadRequest
+	if cont.Request.ContentLength != 0 {
+		if err := cont.ShouldBindJSON(&request); err != nil {
+			writeInvalidRequest(cont, err, fmt.Sprintf("The request json is not correct, %s", err.Error()))
+			return
+		}
+	}
+	checksum := ""
+	if request.Checksum != "" {
+		var err error
+		if checksum, err = utils.NormalizeChecksum(request.Checksum); err != nil {
+			statusCode := http.StatusBadRequest
+			writeProblem(cont, models.ProblemDetail{
+				Status:        statusCode,
+				Title:         "Bad Request",
+				Detail:        err.Error(),
+				InvalidParams: []models.InvalidParam{{Param: "/checksum", Reason: err.Error()}},
+			})
+			return
+		}
+	}
+
+	modelInfo, ok := m.getRegisteredModel(cont, modelName, modelVersion)
+	if !ok {
+		return
+	}
+
+	if _, err := utils.ParseArtifactVersion(artifactVersion); err != nil {
+		statusCode := http.StatusBadRequest
+		writeProblem(cont, models.ProblemDetail{
+			Status: statusCode,
+			Title:  "Bad Request",
+			Detail: err.Error(),
+		})
+		return
+	}
+
+	modelKey := fmt.Sprintf("%s_%s_%s", modelName, modelVersion, artifactVersion)
+	exportBucket := utils.ModelBucketName(modelName)
+	fileName := modelKey + os.Getenv("MODEL_FILE_POSTFIX")
+	objectInfo, err := m.dbmgr.HeadBucketObject(fileName, exportBucket)
+	if err != nil {
+		if errors.Is(err, core.ErrObjectNotFound) {
+			statusCode := http.StatusConflict
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Conflict",
+				Detail: fmt.Sprintf("artifact %s has not been uploaded yet", modelKey),
+			})
+			return
+		}
+		writeError(cont, err, err.Error())
+		return
+	}
+
+	// Only the checksum verified by the storage is recorded, it is known when PresignUpload was given
+	// one. The checksum of the request is compared with it, it can't be trusted on its own.
+	if checksum != "" && objectInfo.Checksum != "" && checksum != objectInfo.Checksum {
+		writeError(cont, utils.ErrChecksumMismatch, fmt.Sprintf("%s: expected %s, got %s", utils.ErrChecksumMismatch, checksum, objectInfo.Checksum))
+		return
+	}
+
+	// Only an artifact version handed out by PresignUpload can be committed, the object is already at its final name
+	committed, err := m.auditedDB(cont).CommitArtifactVersion(modelName, modelVersion, models.ArtifactVersion{
+		Version:    artifactVersion,
+		ObjectName: fileName,
+		Size:       objectInfo.Size,
+		Digest:     objectInfo.Checksum,
+		UploadedBy: requestActor(cont),
+	}, nil)
+	if err != nil {
+		if errors.Is(err, db.ErrArtifactVersionConflict) {
+			statusCode := http.StatusConflict
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Conflict",
+				Detail: fmt.Sprintf("artifactVersion %s can't be committed, current artifactVersion is %s", artifactVersion, modelInfo.ModelId.ArtifactVersion),
+			})
+			return
+		}
+		writeError(cont, err, fmt.Sprintf("Unable to update newArtifactVersion: %s", err.Error()))
+		return
+	}
+
+	logging.INFO("model upload committed")
+	cont.JSON(http.StatusOK, gin.H{
+		"code":      http.StatusOK,
+		"message":   string("Model upload committed.."),
+		"modelinfo": committed,
+	})
+}
+
+/*
+The following API hands out a presigned URL to download an existing artifact directly from the storage
+*/
+func (m *MmeApiHandler) PresignDownload(cont *gin.Context) {
+	logging.INFO("Presign download API ...")
+	modelName := cont.Param("modelName")
+	modelVersion := cont.Param("modelVersion")
+	artifactVersion, ok := m.resolveArtifactVersion(cont, modelName, modelVersion, cont.Param("artifactVersion"))
+	if !ok || !m.checkArtifactNotRevoked(cont, modelName, modelVersion, artifactVersion) {
+		return
+	}
+
+	modelKey := fmt.Sprintf("%s_%s_%s", modelName, modelVersion, artifactVersion)
+	exportBucket := utils.ModelBucketName(modelName)
+	fileName := modelKey + os.Getenv("MODEL_FILE_POSTFIX")
+
+	if _, err := m.dbmgr.HeadBucketObject(fileName, exportBucket); err != nil {
+		if errors.Is(err, core.ErrObjectNotFound) {
+			statusCode := http.StatusNotFound
+			writeProblem(cont, models.ProblemDetail{
+				Status: statusCode,
+				Title:  "Not Found",
+				Detail: fmt.Sprintf("Model artifact not found with modelName: %s, modelVersion: %s and artifactVersion: %s", modelName, modelVersion, artifactVersion),
+			})
+			return
+		}
+		writeError(cont, err, err.Error())
+		return
+	}
+
+	ttl := presignedUrlTTL()
+	url, err := m.dbmgr.PresignDownload(fileName, exportBucket, ttl)
+	if err != nil {
+		presignFailed(cont, err)
+		return
+	}
+	cont.JSON(http.StatusOK, models.PresignedUrlResponse{
+		Url:             url,
+		Method:          http.MethodGet,
+		ArtifactVersion: artifactVersion,
+		ExpiresAt:       time.Now().Add(ttl).UTC(),
+	})
+}
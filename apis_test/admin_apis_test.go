@@ -0,0 +1,81 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+)
+
+const consistencyCheckUrl = "/ai-ml-model-registration/v1/admin/consistency-check"
+
+func TestCheckConsistencyReport(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetAll").Return([]models.ModelRelatedInformation{*registeredModel("1.1.0")}, nil)
+	iDBMockInst.On("ListArtifactVersions", "1234").Return([]models.ArtifactVersion{}, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("ListBucketNames").Return([]string{"test-model", "other"}, nil)
+	old := time.Now().Add(-time.Hour)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return([]core.ObjectInfo{
+		{Name: "test-model_1_1.0.0.zip", LastModified: old},
+		{Name: "test-model_1_1.2.0.zip", LastModified: old},
+	}, nil)
+	dbMgrMockInst.On("GetBucketItems", "other").Return([]core.ObjectInfo{
+		{Name: "other_1_1.0.0.zip", LastModified: time.Now()},
+	}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, consistencyCheckUrl, nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var report models.ConsistencyReport
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &report))
+	assert.Equal(t, 1, report.CheckedModels)
+	assert.Len(t, report.MissingArtifacts, 1)
+	assert.Equal(t, "test-model_1_1.1.0.zip", report.MissingArtifacts[0].ObjectName)
+	// the object of "other" is within the grace period
+	assert.Equal(t, []models.OrphanedObject{
+		{Bucket: "test-model", ObjectName: "test-model_1_1.2.0.zip", Reason: models.OrphanUncommitted},
+	}, report.OrphanedObjects)
+	dbMgrMockInst.AssertNotCalled(t, "DeleteBucketObject")
+}
+
+func TestCheckConsistencyInvalidQuery(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	router := routers.InitRouter(apis.NewMmeApiHandler(new(mme_mocks.DbMgrMock), new(mme_mocks.IDBMock)))
+
+	for _, query := range []string{"?repair=maybe", "?deleteOrphans=2", "?gracePeriod=-1m"} {
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, consistencyCheckUrl+query, nil))
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code, query)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+)
+
+const aliasesUrl = "/ai-ml-model-registration/v1/model-registrations/1234/aliases"
+
+func serveAliases(dbMgrMockInst *mme_mocks.DbMgrMock, iDBMockInst *mme_mocks.IDBMock, req *http.Request) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	return responseRecorder
+}
+
+func newSetAliasRequest(alias string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPut, aliasesUrl+"/"+alias, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestSetArtifactAlias(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("SetArtifactAlias", "1234", "production", "1.0.0").
+		Return(&models.ArtifactAlias{Name: "production", ArtifactVersion: "1.0.0"}, nil)
+
+	responseRecorder := serveAliases(nil, iDBMockInst, newSetAliasRequest("production", `{"artifactVersion": "1.0.0"}`))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var alias models.ArtifactAlias
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &alias))
+	assert.Equal(t, "1.0.0", alias.ArtifactVersion)
+}
+
+func TestSetArtifactAliasFailure(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	tests := []struct {
+		name       string
+		alias      string
+		err        error
+		statusCode int
+	}{
+		{"Latest", "latest", nil, http.StatusBadRequest},
+		{"InvalidName", "Production", nil, http.StatusBadRequest},
+		{"UnknownArtifactVersion", "production", db.ErrNotFound, http.StatusNotFound},
+		{"RevokedArtifactVersion", "production", db.ErrArtifactVersionRevoked, http.StatusConflict},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+			iDBMockInst.On("SetArtifactAlias", "1234", tc.alias, "1.0.0").Return(nil, tc.err)
+
+			responseRecorder := serveAliases(nil, iDBMockInst, newSetAliasRequest(tc.alias, `{"artifactVersion": "1.0.0"}`))
+
+			assert.Equal(t, tc.statusCode, responseRecorder.Code)
+		})
+	}
+}
+
+func TestDeleteArtifactAlias(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("DeleteArtifactAlias", "1234", "staging").Return(int64(1), nil)
+	iDBMockInst.On("DeleteArtifactAlias", "1234", "canary").Return(int64(0), nil)
+
+	responseRecorder := serveAliases(nil, iDBMockInst, httptest.NewRequest(http.MethodDelete, aliasesUrl+"/staging", nil))
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code)
+
+	responseRecorder = serveAliases(nil, iDBMockInst, httptest.NewRequest(http.MethodDelete, aliasesUrl+"/canary", nil))
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestDownloadModelByAlias(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ResolveArtifactAlias", "production").Return("1.0.0", nil)
+	iDBMockInst.On("ResolveArtifactAlias", "staging").Return("", db.ErrNotFound)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("GetArtifactVersion", "1234", "1.0.0").Return(&models.ArtifactVersion{Version: "1.0.0"}, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 4, LastModified: time.Now()}, nil)
+
+	req := httptest.NewRequest(http.MethodHead, "/ai-ml-model-registration/v1/downloadModel/test-model/1/production/model.zip", nil)
+	responseRecorder := serveAliases(dbMgrMockInst, iDBMockInst, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	iDBMockInst.AssertCalled(t, "ResolveArtifactAlias", "production")
+
+	req = httptest.NewRequest(http.MethodHead, "/ai-ml-model-registration/v1/downloadModel/test-model/1/staging/model.zip", nil)
+	responseRecorder = serveAliases(dbMgrMockInst, iDBMockInst, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestGetModelInfoByAlias(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("ResolveArtifactAlias", "production").Return("1.0.0", nil)
+	iDBMockInst.On("GetArtifactVersion", "1234", "1.0.0").Return(&models.ArtifactVersion{Version: "1.0.0", Digest: "aa"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?model-name=test-model&model-version=1&artifact-version=production", nil)
+	responseRecorder := serveAliases(nil, iDBMockInst, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var modelInfos []models.ModelRelatedInformation
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &modelInfos))
+	assert.Equal(t, "1.0.0", modelInfos[0].ModelId.ArtifactVersion)
+	assert.Equal(t, "aa", modelInfos[0].ArtifactChecksum)
+}
+
+func TestGetModelInfoArtifactVersionNeedsModelVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?model-name=test-model&artifact-version=latest", nil)
+	responseRecorder := serveAliases(nil, new(mme_mocks.IDBMock), req)
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
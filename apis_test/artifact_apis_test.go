@@ -0,0 +1,84 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListModelArtifactsSuccess(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	modelInfo := registeredModel("1.10.0")
+	modelInfo.ArtifactChecksum = fakeZipChecksum
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(modelInfo, nil)
+	iDBMockInst.On("ListArtifactVersions", "1234").Return([]models.ArtifactVersion{
+		{Version: "1.2.0", Digest: strings.Repeat("0", 64)},
+		{Version: "1.10.0", Digest: fakeZipChecksum},
+	}, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return([]core.ObjectInfo{
+		{Name: "test-model_1_1.10.0.zip", Size: 21},
+		{Name: "test-model_1_1.2.0.zip", Size: 10},
+		{Name: "test-model_2_1.0.0.zip", Size: 5},
+		{Name: "test-model_info.json", Size: 5},
+	}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/models/test-model/versions/1/artifacts", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var response models.ModelArtifactsResponse
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+	assert.Equal(t, "1234", response.ModelInfo.Id)
+	assert.Equal(t, []models.ArtifactInfo{
+		{ArtifactVersion: "1.2.0", ObjectName: "test-model_1_1.2.0.zip", Size: 10, Checksum: strings.Repeat("0", 64)},
+		{ArtifactVersion: "1.10.0", ObjectName: "test-model_1_1.10.0.zip", Size: 21, Current: true, Checksum: fakeZipChecksum},
+	}, response.Artifacts)
+}
+
+func TestListModelArtifactsStorageFailure(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return(nil, fmt.Errorf("connection refused"))
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/models/test-model/versions/1/artifacts", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+}
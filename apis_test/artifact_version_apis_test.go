@@ -0,0 +1,112 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+)
+
+const artifactVersionsUrl = "/ai-ml-model-registration/v1/model-registrations/1234/artifacts"
+
+func serveArtifactVersions(iDBMockInst *mme_mocks.IDBMock, req *http.Request) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(new(mme_mocks.DbMgrMock), iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	return responseRecorder
+}
+
+func TestListArtifactVersions(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("ListArtifactVersions", "1234").Return([]models.ArtifactVersion{
+		{Version: "1.0.0", Digest: "aa", Status: models.ArtifactStatusDeprecated},
+		{Version: "1.1.0", Digest: "bb", Status: models.ArtifactStatusAvailable},
+	}, nil)
+
+	responseRecorder := serveArtifactVersions(iDBMockInst, httptest.NewRequest(http.MethodGet, artifactVersionsUrl, nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var artifacts []models.ArtifactVersion
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &artifacts))
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, "1.1.0", artifacts[1].Version)
+}
+
+func TestListArtifactVersionsUnknownRegistration(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, db.ErrNotFound)
+
+	responseRecorder := serveArtifactVersions(iDBMockInst, httptest.NewRequest(http.MethodGet, artifactVersionsUrl, nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "ListArtifactVersions", "1234")
+}
+
+func TestGetArtifactVersionNotFound(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("GetArtifactVersion", "1234", "9.9.9").Return(nil, db.ErrNotFound)
+
+	responseRecorder := serveArtifactVersions(iDBMockInst, httptest.NewRequest(http.MethodGet, artifactVersionsUrl+"/9.9.9", nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestMarkArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("SetArtifactVersionStatus", "1234", "1.0.0", "revoked").
+		Return(&models.ArtifactVersion{Version: "1.0.0", Status: models.ArtifactStatusRevoked}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, artifactVersionsUrl+"/1.0.0", strings.NewReader(`{"status": "revoked"}`))
+	req.Header.Set("Content-Type", "application/json")
+	responseRecorder := serveArtifactVersions(iDBMockInst, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var artifact models.ArtifactVersion
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &artifact))
+	assert.Equal(t, models.ArtifactStatusRevoked, artifact.Status)
+}
+
+func TestMarkArtifactVersionInvalidStatus(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+
+	req := httptest.NewRequest(http.MethodPatch, artifactVersionsUrl+"/1.0.0", strings.NewReader(`{"status": "deleted"}`))
+	req.Header.Set("Content-Type", "application/json")
+	responseRecorder := serveArtifactVersions(iDBMockInst, req)
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "SetArtifactVersionStatus", "1234", "1.0.0", "deleted")
+}
@@ -0,0 +1,93 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+)
+
+const historyUrl = "/ai-ml-model-registration/v1/model-registrations/1234/history"
+
+func TestListAuditEvents(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ListAuditEvents", "1234").Return([]models.AuditEvent{{
+		ID:                        "e1",
+		ModelRelatedInformationID: "1234",
+		Action:                    models.AuditRegistrationUpdated,
+		Actor:                     "alice",
+		Before:                    `{"description":"a"}`,
+		After:                     `{"description":"b"}`,
+		Diff:                      `{"description":"b"}`,
+	}}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, historyUrl, nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), `"action":"registration.updated"`)
+	assert.Contains(t, responseRecorder.Body.String(), `"diff":{"description":"b"}`)
+}
+
+func TestListAuditEventsUnknownModel(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ListAuditEvents", "1234").Return([]models.AuditEvent{}, nil)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, db.ErrNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, historyUrl, nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestRequestIdRecordedInAudit(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Restore", "1234").Return(registeredModel("1.1.0"), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations/1234/restore", nil)
+	req.Header.Set("X-Actor", "alice")
+	req.Header.Set("X-Request-Id", "req-1")
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "req-1", responseRecorder.Header().Get("X-Request-Id"))
+	assert.Equal(t, models.AuditContext{Actor: "alice", RequestId: "req-1"}, iDBMockInst.Audit)
+
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations/1234/restore", nil))
+
+	generated := responseRecorder.Header().Get("X-Request-Id")
+	assert.NotEmpty(t, generated)
+	assert.Equal(t, models.AuditContext{RequestId: generated}, iDBMockInst.Audit)
+}
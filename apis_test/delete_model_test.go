@@ -0,0 +1,206 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const deleteUrl = "/ai-ml-model-registration/v1/model-registrations/1234"
+
+var storedArtifacts = []core.ObjectInfo{
+	{Name: "test-model_1_1.0.0.zip", Size: 10},
+	{Name: "test-model_1_1.1.0.zip", Size: 21},
+}
+
+func deleteModel(dbMgrMockInst *mme_mocks.DbMgrMock, iDBMockInst *mme_mocks.IDBMock) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, deleteUrl, nil)
+	req.Header.Set("X-Actor", "alice")
+	router.ServeHTTP(responseRecorder, req)
+	return responseRecorder
+}
+
+func TestDeleteModelNotFound(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, db.ErrNotFound)
+
+	responseRecorder := deleteModel(new(mme_mocks.DbMgrMock), iDBMockInst)
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "Delete", "1234", mock.Anything, mock.Anything)
+}
+
+func TestDeleteModelNoRowsAffected(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_DELETE_POLICY", "orphan")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("Delete", "1234", "alice", int64(1)).Return(int64(0), nil)
+
+	responseRecorder := deleteModel(new(mme_mocks.DbMgrMock), iDBMockInst)
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestDeleteModelOrphanPolicy(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_DELETE_POLICY", "orphan")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("Delete", "1234", "alice", int64(1)).Return(int64(1), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+
+	responseRecorder := deleteModel(dbMgrMockInst, iDBMockInst)
+
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code)
+	assert.Empty(t, responseRecorder.Body.String())
+	dbMgrMockInst.AssertNotCalled(t, "GetBucketItems", "test-model")
+}
+
+func TestDeleteModelRefusePolicy(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	os.Setenv("MODEL_DELETE_POLICY", "refuse")
+	defer os.Setenv("MODEL_DELETE_POLICY", "")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return(storedArtifacts, nil)
+
+	responseRecorder := deleteModel(dbMgrMockInst, iDBMockInst)
+
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "Delete", "1234", mock.Anything, mock.Anything)
+}
+
+func TestDeleteModelCascadeKeepsArtifactsUntilPurge(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_DELETE_POLICY", "cascade")
+	defer os.Setenv("MODEL_DELETE_POLICY", "")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("Delete", "1234", "alice", int64(1)).Return(int64(1), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+
+	responseRecorder := deleteModel(dbMgrMockInst, iDBMockInst)
+
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code)
+	dbMgrMockInst.AssertNotCalled(t, "GetBucketItems", "test-model")
+}
+
+func TestRestoreModel(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Restore", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("Restore", "5678").Return(nil, db.ErrNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, deleteUrl+"/restore", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), `"id":"1234"`)
+
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations/5678/restore", nil))
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestPurgeDeletedModelsCascadePolicy(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	os.Setenv("MODEL_DELETE_POLICY", "cascade")
+	defer os.Setenv("MODEL_DELETE_POLICY", "")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ListDeleted").Return([]models.ModelRelatedInformation{*registeredModel("1.1.0")}, nil)
+	iDBMockInst.On("Purge", "1234").Return(int64(1), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return(storedArtifacts, nil)
+	dbMgrMockInst.On("DeleteBucketObject").Return(true)
+	dbMgrMockInst.On("DeleteBucket", "test-model_1_1.1.0.zip", "test-model").Return()
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{}, core.ErrObjectNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/admin/purge", nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var reports []models.DeleteModelReport
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &reports))
+	assert.Len(t, reports, 1)
+	assert.Equal(t, []string{"test-model_1_1.0.0.zip", "test-model_1_1.1.0.zip"}, reports[0].RemovedObjects)
+	assert.Empty(t, reports[0].FailedObjects)
+	assert.True(t, reports[0].BucketRemoved)
+	dbMgrMockInst.AssertNumberOfCalls(t, "DeleteBucketObject", 1)
+}
+
+func TestPurgeDeletedModelsCascadeKeepsSharedBucket(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	os.Setenv("MODEL_DELETE_POLICY", "cascade")
+	defer os.Setenv("MODEL_DELETE_POLICY", "")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ListDeleted").Return([]models.ModelRelatedInformation{*registeredModel("1.1.0")}, nil)
+	iDBMockInst.On("Purge", "1234").Return(int64(1), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return(append(storedArtifacts, core.ObjectInfo{Name: "test-model_2_1.0.0.zip"}), nil)
+	dbMgrMockInst.On("DeleteBucketObject").Return(false)
+
+	reports, err := apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst).PurgeDeletedModels(time.Now(), models.AuditContext{Actor: models.SystemActor})
+
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+	assert.Empty(t, reports[0].RemovedObjects)
+	assert.Equal(t, []string{"test-model_1_1.0.0.zip", "test-model_1_1.1.0.zip"}, reports[0].FailedObjects)
+	assert.False(t, reports[0].BucketRemoved)
+	dbMgrMockInst.AssertNotCalled(t, "DeleteBucket", "test-model_1_1.1.0.zip", "test-model")
+}
+
+func TestPurgeDeletedModelsSkipsRestored(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	os.Setenv("MODEL_DELETE_POLICY", "cascade")
+	defer os.Setenv("MODEL_DELETE_POLICY", "")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("ListDeleted").Return([]models.ModelRelatedInformation{*registeredModel("1.1.0")}, nil)
+	iDBMockInst.On("Purge", "1234").Return(int64(0), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("GetBucketItems", "test-model").Return(storedArtifacts, nil)
+
+	reports, err := apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst).PurgeDeletedModels(time.Now(), models.AuditContext{Actor: models.SystemActor})
+
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+	dbMgrMockInst.AssertNotCalled(t, "DeleteBucketObject")
+}
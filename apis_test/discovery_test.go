@@ -0,0 +1,138 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetModelInfoPagination(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Find", models.ModelInfoQuery{
+		Author:          "someone",
+		PlatformName:    "k8s",
+		InputDataType:   "kpi",
+		EnvironmentType: "prod",
+		Sort: []models.SortField{
+			{Field: models.SortArtifactVersion, Desc: true},
+			{Field: models.SortCreatedAt},
+		},
+		Limit:  10,
+		Offset: 10,
+	}).Return([]models.ModelRelatedInformation{{Id: "1"}}, int64(35), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?author=someone&platform-name=k8s&input-data-type=kpi&environment-type=prod&sort=-artifact-version,created-at&limit=10&offset=10", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "35", responseRecorder.Header().Get("X-Total-Count"))
+	link := responseRecorder.Header().Get("Link")
+	assert.Contains(t, link, `limit=10&offset=0&platform-name=k8s&sort=-artifact-version%2Ccreated-at>; rel="first"`)
+	assert.Contains(t, link, `limit=10&offset=0&platform-name=k8s&sort=-artifact-version%2Ccreated-at>; rel="prev"`)
+	assert.Contains(t, link, `limit=10&offset=20&platform-name=k8s&sort=-artifact-version%2Ccreated-at>; rel="next"`)
+	assert.Contains(t, link, `limit=10&offset=30&platform-name=k8s&sort=-artifact-version%2Ccreated-at>; rel="last"`)
+}
+
+func TestGetModelInfoDefaultPage(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Find", models.ModelInfoQuery{Limit: 100}).Return([]models.ModelRelatedInformation{}, int64(0), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models", nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "[]", responseRecorder.Body.String())
+	assert.NotContains(t, responseRecorder.Header().Get("Link"), `rel="next"`)
+}
+
+func TestGetModelInfoIncludeDeleted(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Find", models.ModelInfoQuery{ModelName: "test-model", IncludeDeleted: true, Limit: 100}).Return([]models.ModelRelatedInformation{{Id: "1", DeletedBy: "alice"}}, int64(1), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?model-name=test-model&include-deleted=true", nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), `"deletedBy":"alice"`)
+}
+
+func TestGetModelInfoInvalidPage(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	for _, query := range []string{"limit=0", "limit=1001", "offset=-1", "limit=ten", "sort=author", "sort=-", "include-deleted=maybe"} {
+		t.Run(query, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+			responseRecorder := httptest.NewRecorder()
+
+			router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?"+query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			iDBMockInst.AssertNotCalled(t, "Find", mock.Anything)
+		})
+	}
+}
+
+func TestSearchModels(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Search", models.SearchQuery{Text: "traffic forecast", Limit: 1, Offset: 0}).Return([]models.SearchResult{{
+		ModelInfo: models.ModelRelatedInformation{Id: "1"},
+		Rank:      0.5,
+		Snippet:   "<mark>traffic</mark> <mark>forecast</mark>",
+	}}, int64(3), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/search?q=traffic+forecast&limit=1", nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), `"rank":0.5`)
+	assert.Equal(t, "3", responseRecorder.Header().Get("X-Total-Count"))
+	assert.Contains(t, responseRecorder.Header().Get("Link"), `limit=1&offset=1&q=traffic+forecast>; rel="next"`)
+}
+
+func TestSearchModelsInvalidQuery(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	for _, query := range []string{"", "q=+", "q=x&limit=0", "q=x&offset=-1"} {
+		iDBMockInst := new(mme_mocks.IDBMock)
+		router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+		responseRecorder := httptest.NewRecorder()
+
+		router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/search?"+query, nil))
+
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code, query)
+		iDBMockInst.AssertNotCalled(t, "Search", mock.Anything)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/testkit"
+	"github.com/stretchr/testify/assert"
+)
+
+// Serves the requests with the handlers running on a fresh test kit
+func testKitServer() func(req *http.Request) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(testkit.NewDBMgr(), testkit.MustNewIDB()))
+	return func(req *http.Request) *httptest.ResponseRecorder {
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		return responseRecorder
+	}
+}
+
+// Builds the upload of an artifact of the model registered by registerModelBody
+func uploadModelRequest(content string, fields map[string]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, value := range fields {
+		writer.WriteField(name, value)
+	}
+	part, _ := writer.CreateFormFile("file", "Model.zip")
+	part.Write([]byte(content))
+	writer.Close()
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/uploadModel/model3/2", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// Goes through the registration, upload, download and deletion of a model on the in-memory test kit
+func TestModelEndToEnd(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	os.Setenv("MODEL_DELETE_POLICY", "orphan")
+	serve := testKitServer()
+
+	responseRecorder := serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code, responseRecorder.Body.String())
+	var registered struct {
+		ModelInfo models.ModelRelatedInformation `json:"modelInfo"`
+	}
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &registered))
+	id := registered.ModelInfo.Id
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+
+	responseRecorder = serve(uploadModelRequest("fake zip file content", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/model3/2/1.0.0/model.zip", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+	content, _ := io.ReadAll(responseRecorder.Body)
+	assert.Equal(t, "fake zip file content", string(content))
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+	var modelInfo models.ModelRelatedInformation
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &modelInfo))
+	assert.Equal(t, "1.0.0", modelInfo.ModelId.ArtifactVersion)
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodDelete, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code, responseRecorder.Body.String())
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+// A PUT of the registration leaves the artifact as it is
+func TestUpdateModelKeepsArtifact(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	serve := testKitServer()
+
+	body := strings.Replace(registerModelBody, `"description"`, `"modelLocation": "https://model-registry.example.com/given", "description"`, 1)
+	responseRecorder := serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(body)))
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code, responseRecorder.Body.String())
+	var registered struct {
+		ModelInfo models.ModelRelatedInformation `json:"modelInfo"`
+	}
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &registered))
+	id := registered.ModelInfo.Id
+	assert.Equal(t, "https://model-registry.example.com/given", registered.ModelInfo.ModelLocation)
+	responseRecorder = serve(uploadModelRequest("fake zip file content", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+
+	update := registered.ModelInfo
+	update.Description = "updated"
+	update.ModelId.ArtifactVersion = "9.0.0"
+	update.ArtifactChecksum = ""
+	update.ModelLocation = "https://model-registry.example.com/elsewhere"
+	updateBody, _ := json.Marshal(update)
+	responseRecorder = serve(httptest.NewRequest(http.MethodPut, "/ai-ml-model-registration/v1/model-registrations/"+id, bytes.NewReader(updateBody)))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var modelInfo models.ModelRelatedInformation
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &modelInfo))
+	assert.Equal(t, "updated", modelInfo.Description)
+	assert.Equal(t, "1.0.0", modelInfo.ModelId.ArtifactVersion)
+	assert.NotEmpty(t, modelInfo.ArtifactChecksum)
+	assert.Equal(t, "https://model-registry.example.com/elsewhere", modelInfo.ModelLocation)
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/model3/2/latest/model.zip", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+}
+
+// The download of a corrupted artifact is cut before it completes
+func TestDownloadCorruptedModel(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	dbMgr := testkit.NewDBMgr()
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgr, testkit.MustNewIDB()))
+
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code, responseRecorder.Body.String())
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, uploadModelRequest("fake zip file content", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+	// same length, other content
+	assert.NoError(t, dbMgr.UploadFile(strings.NewReader("fake zip file CONTENT"), "model3_2_1.0.0.zip", "model3"))
+
+	responseRecorder = httptest.NewRecorder()
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/model3/2/1.0.0/model.zip", nil))
+	})
+	assert.Equal(t, "21", responseRecorder.Header().Get("Content-Length"))
+	assert.Less(t, responseRecorder.Body.Len(), 21)
+}
+
+// An explicit artifact version isn't used up by an upload which failed or was never made
+func TestUploadRetriesExplicitArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	serve := testKitServer()
+
+	responseRecorder := serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code, responseRecorder.Body.String())
+
+	wrongChecksum := strings.Repeat("0", 64)
+	responseRecorder = serve(uploadModelRequest("fake zip file content", map[string]string{"artifactVersion": "2.0.0", "checksum": wrongChecksum}))
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code, responseRecorder.Body.String())
+	responseRecorder = serve(uploadModelRequest("fake zip file content", map[string]string{"artifactVersion": "2.0.0"}))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+	responseRecorder = serve(uploadModelRequest("fake zip file content", map[string]string{"artifactVersion": "2.0.0"}))
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code, responseRecorder.Body.String())
+
+	// the first presigned URL expires unused
+	for i := 0; i < 2; i++ {
+		responseRecorder = serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/presignedUpload/model3/2", strings.NewReader(`{"artifactVersion": "3.0.0"}`)))
+		assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+		var presigned models.PresignedUrlResponse
+		assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &presigned))
+		assert.Equal(t, "3.0.0", presigned.ArtifactVersion)
+	}
+}
+
+// Registering the model name and version of a deleted registration points to its restoration
+func TestRegisterDeletedModel(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_DELETE_POLICY", "orphan")
+	serve := testKitServer()
+
+	responseRecorder := serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code, responseRecorder.Body.String())
+	var registered struct {
+		ModelInfo models.ModelRelatedInformation `json:"modelInfo"`
+	}
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &registered))
+	id := registered.ModelInfo.Id
+	responseRecorder = serve(httptest.NewRequest(http.MethodDelete, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code, responseRecorder.Body.String())
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations", strings.NewReader(registerModelBody)))
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+	var problem models.ProblemDetail
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &problem))
+	assert.Contains(t, problem.Detail, "/ai-ml-model-registration/v1/model-registrations/"+id+"/restore")
+
+	responseRecorder = serve(httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/model-registrations/"+id+"/restore", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+	responseRecorder = serve(httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/model-registrations/"+id, nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
@@ -0,0 +1,144 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRepositoryErrorStatus(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	testCases := []struct {
+		name       string
+		err        error
+		statusCode int
+	}{
+		{"NotFound", db.ErrNotFound, http.StatusNotFound},
+		{"WrappedNotFound", fmt.Errorf("registration deleted: %w", db.ErrNotFound), http.StatusNotFound},
+		{"Conflict", fmt.Errorf("%w: duplicate key", db.ErrConflict), http.StatusConflict},
+		{"LifecycleTransition", db.ErrLifecycleTransition, http.StatusConflict},
+		{"Internal", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+			iDBMockInst.On("Update", mock.Anything).Return(testCase.err)
+
+			responseRecorder := updateModel(iDBMockInst, "")
+
+			assert.Equal(t, testCase.statusCode, responseRecorder.Code)
+			assert.Contains(t, responseRecorder.Body.String(), fmt.Sprintf(`"status":%d`, testCase.statusCode))
+			assert.Contains(t, responseRecorder.Body.String(), testCase.err.Error())
+		})
+	}
+}
+
+func TestGetModelInfoByIdInternalError(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, errors.New("connection refused"))
+	responseRecorder := httptest.NewRecorder()
+
+	routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst)).ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, registrationUrl, nil))
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"connection refused","instance":"/ai-ml-model-registration/v1/model-registrations/1234"}`, responseRecorder.Body.String())
+}
+
+func TestProblemContentType(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, db.ErrNotFound)
+	responseRecorder := httptest.NewRecorder()
+
+	routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst)).ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, registrationUrl, nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	assert.Equal(t, "application/problem+json", responseRecorder.Header().Get("Content-Type"))
+	assert.Contains(t, responseRecorder.Body.String(), `"type":"about:blank"`)
+	assert.Contains(t, responseRecorder.Body.String(), `"instance":"/ai-ml-model-registration/v1/model-registrations/1234"`)
+}
+
+func TestInvalidParams(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		body          string
+		invalidParams string
+	}{
+		{
+			"MissingNestedField", http.MethodPost, "/ai-ml-model-registration/v1/model-registrations",
+			`{"modelId": {"modelName": "test-model", "modelVersion": "1"}, "description": "d", "modelInformation": {"metadata": {"author": "someone"},
+			"inputDataType": "kpi", "outputDataType": "c", "targetEnvironment": [{"platformName": "k8s", "environmentType": "prod"}]}}`,
+			`"invalidParams":[{"param":"/modelInformation/targetEnvironment/0/dependencyList","reason":"is required"}]`,
+		},
+		{
+			"WrongType", http.MethodPost, "/ai-ml-model-registration/v1/model-registrations",
+			`{"modelId": {"modelName": "test-model", "modelVersion": 1}}`,
+			`"invalidParams":[{"param":"/modelId/modelVersion","reason":"expected string, got number"}]`,
+		},
+		{
+			"NotOneOf", http.MethodPost, "/ai-ml-model-registration/v1/model-registrations/1234/lifecycle",
+			`{"state": "retired"}`,
+			`"invalidParams":[{"param":"/state","reason":"must be one of: registered trained validated deployed deprecated archived"}]`,
+		},
+		{
+			"QueryParameter", http.MethodGet, "/ai-ml-model-discovery/v1/models?limit=0", "",
+			`"invalidParams":[{"param":"limit","reason":"limit must be an integer between 1 and 1000"}]`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			router := routers.InitRouter(apis.NewMmeApiHandler(nil, new(mme_mocks.IDBMock)))
+			responseRecorder := httptest.NewRecorder()
+
+			router.ServeHTTP(responseRecorder, httptest.NewRequest(testCase.method, testCase.url, strings.NewReader(testCase.body)))
+
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Equal(t, "application/problem+json", responseRecorder.Header().Get("Content-Type"))
+			assert.Contains(t, responseRecorder.Body.String(), testCase.invalidParams)
+		})
+	}
+}
+
+func TestUnknownRouteProblem(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	responseRecorder := httptest.NewRecorder()
+
+	routers.InitRouter(apis.NewMmeApiHandler(nil, nil)).ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/unknown", nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	assert.Equal(t, "application/problem+json", responseRecorder.Header().Get("Content-Type"))
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"no resource at GET /ai-ml-model-registration/v1/unknown","instance":"/ai-ml-model-registration/v1/unknown"}`, responseRecorder.Body.String())
+}
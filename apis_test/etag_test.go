@@ -0,0 +1,126 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const registrationUrl = "/ai-ml-model-registration/v1/model-registrations/1234"
+
+var updateModelBody = `{
+	"modelId": {"modelName": "test-model", "modelVersion": "1"},
+	"description": "updated",
+	"modelInformation": {
+		"metadata": {"author": "someone"},
+		"inputDataType": "kpi",
+		"outputDataType": "c"
+	}
+}`
+
+func updateModel(iDBMockInst *mme_mocks.IDBMock, ifMatch string) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, registrationUrl, strings.NewReader(updateModelBody))
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	router.ServeHTTP(responseRecorder, req)
+	return responseRecorder
+}
+
+func TestGetModelInfoByIdETag(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := registeredModel("1.1.0")
+	modelInfo.RowVersion = 7
+	iDBMockInst.On("GetModelInfoById", "1234").Return(modelInfo, nil)
+	responseRecorder := httptest.NewRecorder()
+
+	routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst)).ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, registrationUrl, nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"7"`, responseRecorder.Header().Get("ETag"))
+}
+
+func TestUpdateModelIfMatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("Update", mock.MatchedBy(func(modelInfo models.ModelRelatedInformation) bool {
+		return modelInfo.Id == "1234" && modelInfo.RowVersion == 1 && modelInfo.Description == "updated"
+	})).Return(nil)
+
+	responseRecorder := updateModel(iDBMockInst, `"1"`)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+}
+
+func TestUpdateModelStaleIfMatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	for _, ifMatch := range []string{`"2"`, `W/"1"`, `1`, `"one"`} {
+		iDBMockInst := new(mme_mocks.IDBMock)
+		iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+
+		responseRecorder := updateModel(iDBMockInst, ifMatch)
+
+		assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code, ifMatch)
+		iDBMockInst.AssertNotCalled(t, "Update", mock.Anything)
+	}
+}
+
+func TestUpdateModelStaleWrite(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	for ifMatch, statusCode := range map[string]int{`"1"`: http.StatusPreconditionFailed, "": http.StatusConflict, "*": http.StatusConflict} {
+		iDBMockInst := new(mme_mocks.IDBMock)
+		iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+		iDBMockInst.On("Update", mock.Anything).Return(db.ErrStale)
+
+		responseRecorder := updateModel(iDBMockInst, ifMatch)
+
+		assert.Equal(t, statusCode, responseRecorder.Code, ifMatch)
+	}
+}
+
+func TestDeleteModelStaleIfMatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_DELETE_POLICY", "orphan")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.1.0"), nil)
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, registrationUrl, nil)
+	req.Header.Set("If-Match", `"3"`)
+
+	routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst)).ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "Delete", "1234", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,112 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const lifecycleUrl = "/ai-ml-model-registration/v1/model-registrations/1234/lifecycle"
+
+func newTransitionRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, lifecycleUrl, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "alice")
+	return req
+}
+
+func TestTransitionLifecycleState(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.0.0"), nil)
+	iDBMockInst.On("TransitionLifecycleState", "1234", "trained", "alice", "first training").
+		Return(&models.LifecycleTransition{FromState: "registered", ToState: "trained", Actor: "alice"}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newTransitionRequest(`{"state": "trained", "reason": "first training"}`))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var transition models.LifecycleTransition
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &transition))
+	assert.Equal(t, "trained", transition.ToState)
+	assert.Equal(t, "alice", transition.Actor)
+}
+
+func TestTransitionLifecycleStateNotAllowed(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(registeredModel("1.0.0"), nil)
+	iDBMockInst.On("TransitionLifecycleState", "1234", "deployed", "alice", "").
+		Return(nil, fmt.Errorf("%w: registered to deployed", db.ErrLifecycleTransition))
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newTransitionRequest(`{"state": "deployed"}`))
+
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+}
+
+func TestTransitionLifecycleStateUnknownState(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newTransitionRequest(`{"state": "retired"}`))
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "GetModelInfoById", "1234")
+}
+
+func TestGetModelInfoFilteredByLifecycleState(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("Find", mock.MatchedBy(func(query models.ModelInfoQuery) bool {
+		return query.LifecycleState == models.LifecycleDeployed
+	})).Return([]models.ModelRelatedInformation{
+		{Id: "1", LifecycleState: models.LifecycleDeployed},
+		{Id: "3", LifecycleState: models.LifecycleDeployed},
+	}, int64(2), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?lifecycle-state=deployed", nil))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var modelInfos []models.ModelRelatedInformation
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &modelInfos))
+	assert.Len(t, modelInfos, 2)
+
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/ai-ml-model-discovery/v1/models?lifecycle-state=retired", nil))
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
@@ -18,6 +18,9 @@ limitations under the License.
 package mme_mocks
 
 import (
+	"io"
+	"time"
+
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
 	"github.com/stretchr/testify/mock"
 )
@@ -32,7 +35,9 @@ func (d *DbMgrMock) CreateBucket(bucketName string) (err error) {
 	return args.Error(0)
 }
 
-func (d *DbMgrMock) UploadFile(dataBytes []byte, file_name string, bucketName string) error {
+func (d *DbMgrMock) UploadFile(data io.Reader, file_name string, bucketName string) error {
+	// Drain the data like a real storage would do
+	io.Copy(io.Discard, data)
 	args := d.Called()
 	// If error is passed, return the error
 	if _, ok := args.Get(0).(error); ok {
@@ -46,3 +51,69 @@ func (d *DbMgrMock) ListBucket(bucketObjPostfix string) ([]core.Bucket, error) {
 	args := d.Called()
 	return args.Get(0).([]core.Bucket), args.Error(1)
 }
+
+func (d *DbMgrMock) ListBucketNames() ([]string, error) {
+	args := d.Called()
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), nil
+}
+
+func (d *DbMgrMock) GetBucketObject(objectName string, bucketName string) (*core.BucketObjectReader, error) {
+	args := d.Called()
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*core.BucketObjectReader), nil
+}
+
+func (d *DbMgrMock) DeleteBucketObject(objectName string, bucketName string) bool {
+	args := d.Called()
+	return args.Bool(0)
+}
+
+func (d *DbMgrMock) MoveBucketObject(srcObjectName string, dstObjectName string, bucketName string) error {
+	args := d.Called(dstObjectName)
+	return args.Error(0)
+}
+
+func (d *DbMgrMock) DeleteBucket(objectName string, bucketName string) {
+	d.Called(objectName, bucketName)
+}
+
+func (d *DbMgrMock) GetBucketObjectRange(objectName string, bucketName string, offset int64, length int64) (*core.BucketObjectReader, error) {
+	args := d.Called(offset, length)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*core.BucketObjectReader), nil
+}
+
+func (d *DbMgrMock) HeadBucketObject(objectName string, bucketName string) (core.ObjectInfo, error) {
+	args := d.Called()
+	return args.Get(0).(core.ObjectInfo), args.Error(1)
+}
+
+func (d *DbMgrMock) SupportsPresign() bool {
+	args := d.Called()
+	return args.Bool(0)
+}
+
+func (d *DbMgrMock) PresignUpload(objectName string, bucketName string, checksum string, expiry time.Duration) (string, error) {
+	args := d.Called(objectName, checksum)
+	return args.String(0), args.Error(1)
+}
+
+func (d *DbMgrMock) PresignDownload(objectName string, bucketName string, expiry time.Duration) (string, error) {
+	args := d.Called(objectName)
+	return args.String(0), args.Error(1)
+}
+
+func (d *DbMgrMock) GetBucketItems(bucketName string) ([]core.ObjectInfo, error) {
+	args := d.Called(bucketName)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.ObjectInfo), nil
+}
@@ -18,6 +18,8 @@ limitations under the License.
 package mme_mocks
 
 import (
+	"time"
+
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
 	"github.com/stretchr/testify/mock"
@@ -26,6 +28,8 @@ import (
 type IDBMock struct {
 	mock.Mock
 	db.IDB
+	// audit context of the last WithAudit call
+	Audit models.AuditContext
 }
 
 func (i *IDBMock) Create(modelInfo models.ModelRelatedInformation) error {
@@ -47,12 +51,110 @@ func (i *IDBMock) GetAll() ([]models.ModelRelatedInformation, error) {
 	}
 }
 
+func (i *IDBMock) Find(query models.ModelInfoQuery) ([]models.ModelRelatedInformation, int64, error) {
+	args := i.Called(query)
+	if _, ok := args.Get(2).(error); ok {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.ModelRelatedInformation), args.Get(1).(int64), nil
+}
+
+func (i *IDBMock) Search(query models.SearchQuery) ([]models.SearchResult, int64, error) {
+	args := i.Called(query)
+	if _, ok := args.Get(2).(error); ok {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.SearchResult), args.Get(1).(int64), nil
+}
+
 func (i *IDBMock) Update(modelInfo models.ModelRelatedInformation) error {
-	return nil
+	args := i.Called(modelInfo)
+	return args.Error(0)
+}
+
+func (i *IDBMock) SetCurrentArtifactVersion(modelName string, modelVersion string, artifactVersion string, checksum string, rowVersion int64) error {
+	args := i.Called(modelName, modelVersion, artifactVersion, checksum, rowVersion)
+	return args.Error(0)
+}
+
+func (i *IDBMock) Delete(id string, deletedBy string, rowVersion int64) (int64, error) {
+	args := i.Called(id, deletedBy, rowVersion)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (i *IDBMock) Restore(id string) (*models.ModelRelatedInformation, error) {
+	args := i.Called(id)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ModelRelatedInformation), nil
+}
+
+func (i *IDBMock) ListDeleted(deletedBefore time.Time) ([]models.ModelRelatedInformation, error) {
+	args := i.Called()
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModelRelatedInformation), nil
+}
+
+func (i *IDBMock) Purge(id string) (int64, error) {
+	args := i.Called(id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (i *IDBMock) AllocateArtifactVersion(modelName string, modelVersion string, requested string) (string, error) {
+	args := i.Called(requested)
+	return args.String(0), args.Error(1)
+}
+
+// The promote callback runs when no error is configured, like the repository does
+func (i *IDBMock) CommitArtifactVersion(modelName string, modelVersion string, artifact models.ArtifactVersion, promote func() error) (*models.ModelRelatedInformation, error) {
+	args := i.Called(artifact.Version)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	if promote != nil {
+		if err := promote(); err != nil {
+			return nil, err
+		}
+	}
+	modelInfo := args.Get(0).(*models.ModelRelatedInformation)
+	modelInfo.ModelId.ArtifactVersion = artifact.Version
+	modelInfo.ArtifactChecksum = artifact.Digest
+	return modelInfo, nil
+}
+
+func (i *IDBMock) ListArtifactVersions(modelRegistrationId string) ([]models.ArtifactVersion, error) {
+	args := i.Called(modelRegistrationId)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArtifactVersion), nil
+}
+
+func (i *IDBMock) GetArtifactVersion(modelRegistrationId string, artifactVersion string) (*models.ArtifactVersion, error) {
+	args := i.Called(modelRegistrationId, artifactVersion)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArtifactVersion), nil
 }
 
-func (i *IDBMock) Delete(id string) (int64, error) {
-	return 1, nil
+func (i *IDBMock) SetArtifactVersionStatus(modelRegistrationId string, artifactVersion string, status string) (*models.ArtifactVersion, error) {
+	args := i.Called(modelRegistrationId, artifactVersion, status)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArtifactVersion), nil
+}
+
+func (i *IDBMock) GetModelInfoById(id string) (*models.ModelRelatedInformation, error) {
+	args := i.Called(id)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ModelRelatedInformation), nil
 }
 
 func (i *IDBMock) GetModelInfoByName(modelName string) ([]models.ModelRelatedInformation, error) {
@@ -75,3 +177,58 @@ func (i *IDBMock) GetModelInfoByNameAndVer(modelName string, modelVersion string
 		return emptyModelInfo, args.Error(1)
 	}
 }
+
+func (i *IDBMock) ListArtifactAliases(modelRegistrationId string) ([]models.ArtifactAlias, error) {
+	args := i.Called(modelRegistrationId)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArtifactAlias), nil
+}
+
+func (i *IDBMock) SetArtifactAlias(modelRegistrationId string, alias string, artifactVersion string) (*models.ArtifactAlias, error) {
+	args := i.Called(modelRegistrationId, alias, artifactVersion)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArtifactAlias), nil
+}
+
+func (i *IDBMock) DeleteArtifactAlias(modelRegistrationId string, alias string) (int64, error) {
+	args := i.Called(modelRegistrationId, alias)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (i *IDBMock) ResolveArtifactAlias(modelName string, modelVersion string, alias string) (string, error) {
+	args := i.Called(alias)
+	return args.String(0), args.Error(1)
+}
+
+func (i *IDBMock) TransitionLifecycleState(modelRegistrationId string, state string, actor string, reason string) (*models.LifecycleTransition, error) {
+	args := i.Called(modelRegistrationId, state, actor, reason)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.LifecycleTransition), nil
+}
+
+func (i *IDBMock) ListLifecycleTransitions(modelRegistrationId string) ([]models.LifecycleTransition, error) {
+	args := i.Called(modelRegistrationId)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.LifecycleTransition), nil
+}
+
+func (i *IDBMock) ListAuditEvents(modelRegistrationId string) ([]models.AuditEvent, error) {
+	args := i.Called(modelRegistrationId)
+	if _, ok := args.Get(1).(error); ok {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AuditEvent), nil
+}
+
+func (i *IDBMock) WithAudit(audit models.AuditContext) db.IDB {
+	i.Audit = audit
+	return i
+}
@@ -19,7 +19,10 @@ package apis_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -27,16 +30,20 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
-	"github.com/jackc/pgerrcode"
-	"github.com/lib/pq"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -113,7 +120,7 @@ func TestRegisterModelFailInvalidJson(t *testing.T) {
 	assert.Equal(t, 400, w.Code)
 	body, _ := io.ReadAll(w.Body)
 
-	assert.Equal(t, `{"status":400,"title":"Bad Request","detail":"The request json is not correct, unexpected EOF"}`, string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"The request json is not correct, unexpected EOF","instance":"/ai-ml-model-registration/v1/model-registrations"}`, string(body))
 }
 
 func TestRegisterModelFailInvalidRequest(t *testing.T) {
@@ -128,13 +135,14 @@ func TestRegisterModelFailInvalidRequest(t *testing.T) {
 	assert.Equal(t, 400, w.Code)
 	body, _ := io.ReadAll(w.Body)
 
-	assert.Equal(t, `{"status":400,"title":"Bad Request","detail":"The request json is not correct as it can't be validated, Key: 'ModelRelatedInformation.ModelId.ModelVersion' Error:Field validation for 'ModelVersion' failed on the 'required' tag"}`, string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"The request json is not correct as it can't be validated, Key: 'ModelRelatedInformation.modelId.modelVersion' Error:Field validation for 'modelVersion' failed on the 'required' tag","instance":"/ai-ml-model-registration/v1/model-registrations","invalidParams":[{"param":"/modelId/modelVersion","reason":"is required"}]}`, string(body))
 }
 
 func TestRegisterModelFailCreateDuplicateModel(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 	iDBMockInst := new(mme_mocks.IDBMock)
-	iDBMockInst.On("Create", mock.Anything).Return(&pq.Error{Code: pgerrcode.UniqueViolation})
+	iDBMockInst.On("Create", mock.Anything).Return(fmt.Errorf("%w: duplicate key", db.ErrConflict))
+	iDBMockInst.On("Find", mock.Anything).Return([]models.ModelRelatedInformation{{Id: "1234"}}, int64(1), nil)
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
 	w := httptest.NewRecorder()
@@ -144,13 +152,13 @@ func TestRegisterModelFailCreateDuplicateModel(t *testing.T) {
 	assert.Equal(t, 409, w.Code)
 	body, _ := io.ReadAll(w.Body)
 
-	assert.Equal(t, "{\"status\":409,\"title\":\"Conflict\",\"detail\":\"model name and version combination already present\"}", string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Conflict","status":409,"detail":"model name and version combination already present","instance":"/ai-ml-model-registration/v1/model-registrations"}`, string(body))
 }
 
 func TestRegisterModelFailCreate(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 	iDBMockInst := new(mme_mocks.IDBMock)
-	iDBMockInst.On("Create", mock.Anything).Return(&pq.Error{Code: pgerrcode.SQLClientUnableToEstablishSQLConnection})
+	iDBMockInst.On("Create", mock.Anything).Return(errors.New("connection refused"))
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
 	w := httptest.NewRecorder()
@@ -160,14 +168,14 @@ func TestRegisterModelFailCreate(t *testing.T) {
 	assert.Equal(t, 500, w.Code)
 	body, _ := io.ReadAll(w.Body)
 
-	assert.Equal(t, "{\"status\":500,\"title\":\"Internal Server Error\",\"detail\":\"Database error: pq: \"}", string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Database error: connection refused","instance":"/ai-ml-model-registration/v1/model-registrations"}`, string(body))
 }
 
 func TestWhenSuccessGetModelInfoList(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 
 	iDBmockInst := new(mme_mocks.IDBMock)
-	iDBmockInst.On("GetAll").Return([]models.ModelRelatedInformation{
+	iDBmockInst.On("Find", mock.Anything).Return([]models.ModelRelatedInformation{
 		{
 			Id: "1234",
 			ModelId: models.ModelID{
@@ -183,7 +191,7 @@ func TestWhenSuccessGetModelInfoList(t *testing.T) {
 				OutputDataType: "c,d",
 			},
 		},
-	}, nil)
+	}, int64(1), nil)
 
 	handler := apis.NewMmeApiHandler(nil, iDBmockInst)
 	router := routers.InitRouter(handler)
@@ -207,7 +215,7 @@ func TestWhenFailGetModelInfoList(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 
 	iDBmockInst2 := new(mme_mocks.IDBMock)
-	iDBmockInst2.On("GetAll").Return([]models.ModelRelatedInformation{}, fmt.Errorf("db not available"))
+	iDBmockInst2.On("Find", mock.Anything).Return(nil, int64(0), fmt.Errorf("db not available"))
 
 	handler := apis.NewMmeApiHandler(nil, iDBmockInst2)
 	router := routers.InitRouter(handler)
@@ -240,14 +248,16 @@ func TestGetModelInfoParamsInvalid(t *testing.T) {
 	fmt.Println(responseRecorder)
 
 	assert.Equal(t, 400, responseRecorder.Code)
-	assert.Equal(t, `{"status":400,"title":"Bad Request","detail":"Only allowed params are modelname and modelversion"}`, string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"Only allowed params are model-name, model-version, artifact-version, lifecycle-state, author, owner, input-data-type, output-data-type, platform-name, environment-type, include-deleted, sort, limit, offset","instance":"/ai-ml-model-discovery/v1/models","invalidParams":[{"param":"model-me","reason":"is not a known parameter"}]}`, string(body))
 }
 
 func TestGetModelInfoByNameSuccess(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 
 	iDBMockInst := new(mme_mocks.IDBMock)
-	iDBMockInst.On("GetModelInfoByName").Return([]models.ModelRelatedInformation{
+	iDBMockInst.On("Find", mock.MatchedBy(func(query models.ModelInfoQuery) bool {
+		return query.ModelName == "qoe1"
+	})).Return([]models.ModelRelatedInformation{
 		{
 			Id: "1234",
 			ModelId: models.ModelID{
@@ -263,7 +273,7 @@ func TestGetModelInfoByNameSuccess(t *testing.T) {
 				OutputDataType: "c,d",
 			},
 		},
-	}, nil)
+	}, int64(1), nil)
 
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
@@ -287,7 +297,7 @@ func TestGetModelInfoByNameFail(t *testing.T) {
 	os.Setenv("LOG_FILE_NAME", "testing")
 
 	iDBMockInst := new(mme_mocks.IDBMock)
-	iDBMockInst.On("GetModelInfoByName").Return([]models.ModelRelatedInformation{}, fmt.Errorf("db not available"))
+	iDBMockInst.On("Find", mock.Anything).Return(nil, int64(0), fmt.Errorf("db not available"))
 
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
@@ -299,7 +309,7 @@ func TestGetModelInfoByNameFail(t *testing.T) {
 	body, _ := io.ReadAll(responseRecorder.Body)
 
 	assert.Equal(t, 500, responseRecorder.Code)
-	assert.Equal(t, `{"status":500,"title":"Internal Server Error","detail":"Can't fetch the models due to , db not available"}`, string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Can't fetch the models due to , db not available","instance":"/ai-ml-model-discovery/v1/models"}`, string(body))
 }
 
 func TestGetModelInfoByNameAndVersionSuccess(t *testing.T) {
@@ -324,6 +334,7 @@ func TestGetModelInfoByNameAndVersionSuccess(t *testing.T) {
 	}
 
 	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
 
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
@@ -364,7 +375,7 @@ func TestGetModelInfoByNameAndVersionFail(t *testing.T) {
 	json.Unmarshal(body, &modelInfos)
 
 	assert.Equal(t, 500, responseRecorder.Code)
-	assert.Equal(t, `{"status":500,"title":"Internal Server Error","detail":"Can't fetch all the models due to , db not available"}`, string(body))
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Can't fetch all the models due to , db not available","instance":"/ai-ml-model-discovery/v1/models"}`, string(body))
 }
 
 func TestUploadModelSuccess(t *testing.T) {
@@ -383,9 +394,12 @@ func TestUploadModelSuccess(t *testing.T) {
 		},
 	}
 	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(&modelInfo, nil)
 
 	dbMgrMockInst := new(mme_mocks.DbMgrMock)
 	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("MoveBucketObject", "test-model_1_1.1.0.zip").Return(nil)
 	handler := apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst)
 	router := routers.InitRouter(handler)
 	responseRecorder := httptest.NewRecorder()
@@ -433,8 +447,7 @@ func TestUploadModelFailureModelNotRegistered(t *testing.T) {
 	iDBMockInst := new(mme_mocks.IDBMock)
 	modelName := "test-model"
 	modelVersion := "1"
-	// Returns Empty model, signifying Model is Not registered
-	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&models.ModelRelatedInformation{}, nil)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
 	handler := apis.NewMmeApiHandler(nil, iDBMockInst)
 	router := routers.InitRouter(handler)
 	responseRecorder := httptest.NewRecorder()
@@ -449,7 +462,7 @@ func TestUploadModelFailureModelNotRegistered(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
 	assert.Equal(
 		t,
-		fmt.Sprintf(`{"status":404,"title":"Not Found","detail":"ModelName: %s and modelVersion: %s is not registered, Kindly register it first!"}`, modelName, modelVersion),
+		fmt.Sprintf(`{"type":"about:blank","title":"Not Found","status":404,"detail":"ModelName: %s and modelVersion: %s is not registered, Kindly register it first!","instance":"/ai-ml-model-registration/v1/uploadModel/test-model/1"}`, modelName, modelVersion),
 		string(responseBody),
 	)
 }
@@ -470,6 +483,7 @@ func TestUploadModelFailureModelUploadFailure(t *testing.T) {
 		},
 	}
 	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
 
 	dbMgrMockInst := new(mme_mocks.DbMgrMock)
 	// Simulate Model-upload-failure
@@ -496,5 +510,385 @@ func TestUploadModelFailureModelUploadFailure(t *testing.T) {
 	response := responseRecorder.Result()
 	responseBody, _ := io.ReadAll(response.Body)
 	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
-	assert.Equal(t, `{"code":500,"message":"Unable to upload model"}`, string(responseBody))
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Failed to Upload Model : Unable to upload model, artifact-version 1.1.0 is not committed","instance":"/ai-ml-model-registration/v1/uploadModel/test-model/1"}`, string(responseBody))
+}
+
+func TestDownloadModelSuccess(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	content := "fake zip file content"
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Name: "test-model_1_1.0.0.zip", Size: int64(len(content))}, nil)
+	dbMgrMockInst.On("GetBucketObject").Return(&core.BucketObjectReader{
+		ReadCloser: io.NopCloser(strings.NewReader(content)),
+		ObjectInfo: core.ObjectInfo{Name: "test-model_1_1.0.0.zip", Size: int64(len(content))},
+	}, nil)
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
+	handler := apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst)
+	router := routers.InitRouter(handler)
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/test-model/1/1.0.0/model.zip", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/zip", responseRecorder.Header().Get("Content-Type"))
+	assert.Equal(t, strconv.Itoa(len(content)), responseRecorder.Header().Get("Content-Length"))
+	assert.Equal(t, content, responseRecorder.Body.String())
+}
+
+func TestDownloadModelFailure(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{}, fmt.Errorf("connection refused"))
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
+	handler := apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst)
+	router := routers.InitRouter(handler)
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/test-model/1/1.0.0/model.zip", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+}
+
+// sha256 of "fake zip file content"
+const fakeZipChecksum = "738ff512cd43e35197e130e288ff53d7e821f828eafba5b2e26f67f31e040911"
+
+func newUploadRequest(t *testing.T, modelName string, modelVersion string, fields map[string]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "Model.zip")
+	assert.NoError(t, err)
+	_, err = part.Write([]byte("fake zip file content"))
+	assert.NoError(t, err)
+	for key, value := range fields {
+		assert.NoError(t, writer.WriteField(key, value))
+	}
+	writer.Close()
+
+	url := fmt.Sprintf("/ai-ml-model-registration/v1/uploadModel/%s/%s", modelName, modelVersion)
+	req := httptest.NewRequest(http.MethodPost, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestUploadModelReturnsChecksum(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := models.ModelRelatedInformation{
+		ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+	}
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(&modelInfo, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("MoveBucketObject", "test-model_1_1.1.0.zip").Return(nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", map[string]string{
+		"checksum": "sha256:" + fakeZipChecksum,
+	}))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var responseJson struct {
+		Checksum  string                         `json:"checksum"`
+		ModelInfo models.ModelRelatedInformation `json:"modelinfo"`
+	}
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &responseJson))
+	assert.Equal(t, fakeZipChecksum, responseJson.Checksum)
+	assert.Equal(t, fakeZipChecksum, responseJson.ModelInfo.ArtifactChecksum)
+}
+
+func TestUploadModelFailureChecksumMismatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := models.ModelRelatedInformation{
+		ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+	}
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("DeleteBucketObject").Return(true)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", map[string]string{
+		"checksum": strings.Repeat("0", 64),
+	}))
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	dbMgrMockInst.AssertCalled(t, "DeleteBucketObject")
+	// the artifact version is left untouched
+	assert.Equal(t, "1.0.0", modelInfo.ModelId.ArtifactVersion)
+	iDBMockInst.AssertNotCalled(t, "CommitArtifactVersion", "1.1.0")
+}
+
+func TestUploadModelFailureMoveKeepsArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := models.ModelRelatedInformation{
+		ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+	}
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(&modelInfo, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("MoveBucketObject", "test-model_1_1.1.0.zip").Return(fmt.Errorf("copy failed"))
+	dbMgrMockInst.On("DeleteBucketObject").Return(true)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+	assert.Equal(t, "1.0.0", modelInfo.ModelId.ArtifactVersion)
+	// the staging object is removed
+	dbMgrMockInst.AssertCalled(t, "DeleteBucketObject")
+}
+
+func TestUploadModelCommitConflict(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := models.ModelRelatedInformation{
+		ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+	}
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(nil, db.ErrArtifactVersionConflict)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("DeleteBucketObject").Return(true)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", nil))
+
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+	dbMgrMockInst.AssertNotCalled(t, "MoveBucketObject", "test-model_1_1.1.0.zip")
+	dbMgrMockInst.AssertCalled(t, "DeleteBucketObject")
+}
+
+func TestDownloadModelRevoked(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.1.0"), nil)
+	iDBMockInst.On("GetArtifactVersion", "1234", "1.0.0").Return(&models.ArtifactVersion{
+		Version: "1.0.0", Status: models.ArtifactStatusRevoked,
+	}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(new(mme_mocks.DbMgrMock), iDBMockInst))
+
+	for _, url := range []string{
+		"/ai-ml-model-registration/v1/downloadModel/test-model/1/1.0.0/model.zip",
+		"/ai-ml-model-registration/v1/presignedDownload/test-model/1/1.0.0",
+	} {
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(t, http.StatusGone, responseRecorder.Code, url)
+	}
+}
+
+func TestDownloadModelWithChecksum(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	content := "fake zip file content"
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: int64(len(content))}, nil)
+	dbMgrMockInst.On("GetBucketObject").Return(&core.BucketObjectReader{
+		ReadCloser: io.NopCloser(strings.NewReader(content)),
+		ObjectInfo: core.ObjectInfo{Size: int64(len(content))},
+	}, nil)
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&models.ModelRelatedInformation{
+		ModelId:          models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+		ArtifactChecksum: fakeZipChecksum,
+	}, nil)
+	iDBMockInst.On("GetArtifactVersion", mock.Anything, "1.0.0").Return(&models.ArtifactVersion{
+		Version: "1.0.0", Digest: fakeZipChecksum, Status: models.ArtifactStatusAvailable,
+	}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/downloadModel/test-model/1/1.0.0/model.zip", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"`+fakeZipChecksum+`"`, responseRecorder.Header().Get("ETag"))
+	assert.Equal(t, "sha-256="+base64Checksum(t, fakeZipChecksum), responseRecorder.Header().Get("Digest"))
+	assert.Equal(t, content, responseRecorder.Body.String())
+}
+
+func base64Checksum(t *testing.T, checksum string) string {
+	sum, err := hex.DecodeString(checksum)
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sum)
+}
+
+func newDownloadRouter(content string, lastModified time.Time) (*gin.Engine, *mme_mocks.DbMgrMock) {
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: int64(len(content)), LastModified: lastModified}, nil)
+	dbMgrMockInst.On("GetBucketObjectRange", int64(5), int64(3)).Return(&core.BucketObjectReader{
+		ReadCloser: io.NopCloser(strings.NewReader(content[5:8])),
+		ObjectInfo: core.ObjectInfo{Size: 3},
+	}, nil)
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&models.ModelRelatedInformation{
+		ModelId:          models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+		ArtifactChecksum: fakeZipChecksum,
+	}, nil)
+	iDBMockInst.On("GetArtifactVersion", mock.Anything, "1.0.0").Return(&models.ArtifactVersion{
+		Version: "1.0.0", Digest: fakeZipChecksum, Status: models.ArtifactStatusAvailable,
+	}, nil)
+	return routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst)), dbMgrMockInst
+}
+
+const downloadUrl = "/ai-ml-model-registration/v1/downloadModel/test-model/1/1.0.0/model.zip"
+
+func TestDownloadModelRange(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	router, _ := newDownloadRouter("fake zip file content", time.Now())
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, downloadUrl, nil)
+	req.Header.Set("Range", "bytes=5-7")
+	req.Header.Set("If-Range", `"`+fakeZipChecksum+`"`)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusPartialContent, responseRecorder.Code)
+	assert.Equal(t, "bytes 5-7/21", responseRecorder.Header().Get("Content-Range"))
+	assert.Equal(t, "3", responseRecorder.Header().Get("Content-Length"))
+	assert.Equal(t, "zip", responseRecorder.Body.String())
+}
+
+func TestDownloadModelRangeNotSatisfiable(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	router, _ := newDownloadRouter("fake zip file content", time.Now())
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, downloadUrl, nil)
+	req.Header.Set("Range", "bytes=100-")
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, responseRecorder.Code)
+	assert.Equal(t, "bytes */21", responseRecorder.Header().Get("Content-Range"))
+}
+
+func TestDownloadModelIfNoneMatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	router, dbMgrMockInst := newDownloadRouter("fake zip file content", time.Now())
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, downloadUrl, nil)
+	req.Header.Set("If-None-Match", `"`+fakeZipChecksum+`"`)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusNotModified, responseRecorder.Code)
+	assert.Empty(t, responseRecorder.Body.String())
+	dbMgrMockInst.AssertNotCalled(t, "GetBucketObject")
+}
+
+func TestDownloadModelHead(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	router, dbMgrMockInst := newDownloadRouter("fake zip file content", time.Now())
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodHead, downloadUrl, nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "21", responseRecorder.Header().Get("Content-Length"))
+	assert.Equal(t, `"`+fakeZipChecksum+`"`, responseRecorder.Header().Get("ETag"))
+	assert.Empty(t, responseRecorder.Body.String())
+	dbMgrMockInst.AssertNotCalled(t, "GetBucketObject")
+}
+
+func TestDownloadModelNotFound(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{}, core.ErrObjectNotFound)
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, downloadUrl, nil))
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestUploadModelRequestedArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	modelInfo := models.ModelRelatedInformation{
+		ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+	}
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+	iDBMockInst.On("AllocateArtifactVersion", "patch").Return("1.0.1", nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.0.1").Return(&modelInfo, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("UploadFile").Return(nil)
+	dbMgrMockInst.On("MoveBucketObject", "test-model_1_1.0.1.zip").Return(nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", map[string]string{
+		"artifactVersion": "patch",
+	}))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "1.0.1", modelInfo.ModelId.ArtifactVersion)
+}
+
+func TestUploadModelFailureRequestedArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	tests := []struct {
+		requested  string
+		err        error
+		statusCode int
+	}{
+		{"1.0", utils.ErrInvalidArtifactVersion, http.StatusBadRequest},
+		{"0.9.0", utils.ErrArtifactVersionNotIncreased, http.StatusConflict},
+	}
+	for _, tc := range tests {
+		t.Run(tc.requested, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			modelInfo := models.ModelRelatedInformation{
+				ModelId: models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: "1.0.0"},
+			}
+			iDBMockInst.On("GetModelInfoByNameAndVer").Return(&modelInfo, nil)
+			iDBMockInst.On("AllocateArtifactVersion", tc.requested).Return("", tc.err)
+			dbMgrMockInst := new(mme_mocks.DbMgrMock)
+			router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+			responseRecorder := httptest.NewRecorder()
+
+			router.ServeHTTP(responseRecorder, newUploadRequest(t, "test-model", "1", map[string]string{
+				"artifactVersion": tc.requested,
+			}))
+
+			assert.Equal(t, tc.statusCode, responseRecorder.Code)
+			var problem models.ProblemDetail
+			assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &problem))
+			assert.Equal(t, tc.statusCode, problem.Status)
+			dbMgrMockInst.AssertNotCalled(t, "UploadFile")
+		})
+	}
 }
@@ -0,0 +1,166 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func patchableModel() *models.ModelRelatedInformation {
+	modelInfo := registeredModel("1.0.0")
+	modelInfo.Description = "before"
+	modelInfo.LifecycleState = models.LifecycleRegistered
+	modelInfo.ModelInformation = models.ModelInformation{
+		Metadata:       models.Metadata{Author: "someone"},
+		InputDataType:  "kpi",
+		OutputDataType: "c",
+		TargetEnvironment: []models.TargetEnvironment{
+			{PlatformName: "k8s", EnvironmentType: "prod", DependencyList: "x"},
+		},
+	}
+	return modelInfo
+}
+
+func patchModel(iDBMockInst *mme_mocks.IDBMock, contentType string, patch string) *httptest.ResponseRecorder {
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, registrationUrl, strings.NewReader(patch))
+	req.Header.Set("Content-Type", contentType)
+	router.ServeHTTP(responseRecorder, req)
+	return responseRecorder
+}
+
+func TestPatchModel(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	tests := []struct {
+		name        string
+		contentType string
+		patch       string
+		description string
+		platforms   []string
+	}{
+		{"MergeKeepsEnvironments", "application/merge-patch+json", `{"description":"after"}`, "after", []string{"k8s"}},
+		{"MergeReplacesEnvironments", "application/merge-patch+json",
+			`{"modelInformation":{"targetEnvironment":[{"platformName":"edge","environmentType":"test","dependencyList":"y"}]}}`,
+			"before", []string{"edge"}},
+		{"MergeRemovesEnvironments", "application/merge-patch+json", `{"modelInformation":{"targetEnvironment":null}}`, "before", []string{}},
+		{"JSONPatchAppendsEnvironment", "application/json-patch+json",
+			`[{"op":"test","path":"/description","value":"before"},{"op":"add","path":"/modelInformation/targetEnvironment/-","value":{"platformName":"edge","environmentType":"test","dependencyList":"y"}}]`,
+			"before", []string{"k8s", "edge"}},
+		{"JSONPatchRemovesEnvironment", "application/json-patch+json",
+			`[{"op":"remove","path":"/modelInformation/targetEnvironment/0"}]`, "before", []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			iDBMockInst.On("GetModelInfoById", "1234").Return(patchableModel(), nil)
+			var updated models.ModelRelatedInformation
+			iDBMockInst.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+				updated = args.Get(0).(models.ModelRelatedInformation)
+			}).Return(nil)
+
+			responseRecorder := patchModel(iDBMockInst, tc.contentType, tc.patch)
+
+			assert.Equal(t, http.StatusOK, responseRecorder.Code, responseRecorder.Body.String())
+			assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+			assert.Equal(t, tc.description, updated.Description)
+			assert.Equal(t, int64(1), updated.RowVersion)
+			// nil would leave the stored environments alone
+			assert.NotNil(t, updated.ModelInformation.TargetEnvironment)
+			platforms := []string{}
+			for _, te := range updated.ModelInformation.TargetEnvironment {
+				platforms = append(platforms, te.PlatformName)
+			}
+			assert.Equal(t, tc.platforms, platforms)
+			var response models.ModelRelatedInformation
+			assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+			assert.Equal(t, tc.description, response.Description)
+		})
+	}
+}
+
+func TestPatchModelRejected(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	tests := []struct {
+		name        string
+		contentType string
+		patch       string
+		statusCode  int
+	}{
+		{"UnsupportedContentType", "application/json", `{"description":"after"}`, http.StatusUnsupportedMediaType},
+		{"MalformedPatch", "application/merge-patch+json", `{"description":`, http.StatusBadRequest},
+		{"FailedTest", "application/json-patch+json", `[{"op":"test","path":"/description","value":"other"}]`, http.StatusBadRequest},
+		{"ReadOnlyField", "application/merge-patch+json", `{"modelId":{"modelName":"renamed"}}`, http.StatusBadRequest},
+		{"LifecycleState", "application/merge-patch+json", `{"lifecycleState":"deployed"}`, http.StatusBadRequest},
+		{"RequiredField", "application/merge-patch+json", `{"description":null}`, http.StatusBadRequest},
+		{"IncompleteEnvironment", "application/merge-patch+json", `{"modelInformation":{"targetEnvironment":[{"platformName":"edge"}]}}`, http.StatusBadRequest},
+		{"WrongType", "application/json-patch+json", `[{"op":"replace","path":"/description","value":5}]`, http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			iDBMockInst := new(mme_mocks.IDBMock)
+			iDBMockInst.On("GetModelInfoById", "1234").Return(patchableModel(), nil)
+
+			responseRecorder := patchModel(iDBMockInst, tc.contentType, tc.patch)
+
+			assert.Equal(t, tc.statusCode, responseRecorder.Code, responseRecorder.Body.String())
+			iDBMockInst.AssertNotCalled(t, "Update", mock.Anything)
+		})
+	}
+}
+
+func TestPatchModelStaleIfMatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(patchableModel(), nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(nil, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, registrationUrl, strings.NewReader(`{"description":"after"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestPatchModelNotFound(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoById", "1234").Return(nil, db.ErrNotFound)
+
+	responseRecorder := patchModel(iDBMockInst, "application/merge-patch+json", `{"description":"after"}`)
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
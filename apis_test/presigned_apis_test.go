@@ -0,0 +1,212 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package apis_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/apis_test/mme_mocks"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func registeredModel(artifactVersion string) *models.ModelRelatedInformation {
+	return &models.ModelRelatedInformation{
+		Id:         "1234",
+		ModelId:    models.ModelID{ModelName: "test-model", ModelVersion: "1", ArtifactVersion: artifactVersion},
+		RowVersion: 1,
+	}
+}
+
+func TestPresignUploadSuccess(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	iDBMockInst.On("AllocateArtifactVersion", "").Return("1.1.0", nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("SupportsPresign").Return(true)
+	dbMgrMockInst.On("PresignUpload", "test-model_1_1.1.0.zip", fakeZipChecksum).Return("https://s3/presigned", nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/presignedUpload/test-model/1",
+		strings.NewReader(`{"checksum":"`+fakeZipChecksum+`"}`))
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var response models.PresignedUrlResponse
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+	assert.Equal(t, "https://s3/presigned", response.Url)
+	assert.Equal(t, http.MethodPut, response.Method)
+	assert.Equal(t, "1.1.0", response.ArtifactVersion)
+	assert.Equal(t, base64Checksum(t, fakeZipChecksum), response.Headers["x-amz-checksum-sha256"])
+}
+
+func TestPresignUploadModelNotRegistered(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(new(mme_mocks.DbMgrMock), iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/presignedUpload/test-model/1", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestPresignUploadNotSupported(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("SupportsPresign").Return(false)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/presignedUpload/test-model/1", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusNotImplemented, responseRecorder.Code)
+	// no artifact version is allocated for nothing
+	iDBMockInst.AssertNotCalled(t, "AllocateArtifactVersion", mock.Anything)
+}
+
+func TestCommitUploadSuccess(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	modelInfo := registeredModel("1.0.0")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(modelInfo, nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(modelInfo, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 21, Checksum: fakeZipChecksum}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/commitUpload/test-model/1/1.1.0",
+		strings.NewReader(`{"checksum":"`+fakeZipChecksum+`"}`))
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "1.1.0", modelInfo.ModelId.ArtifactVersion)
+	assert.Equal(t, fakeZipChecksum, modelInfo.ArtifactChecksum)
+}
+
+func TestCommitUploadUnverifiedChecksum(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	modelInfo := registeredModel("1.0.0")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(modelInfo, nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(modelInfo, nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	// uploaded without checksum, the storage doesn't know it
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 21}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/commitUpload/test-model/1/1.1.0",
+		strings.NewReader(`{"checksum":"`+fakeZipChecksum+`"}`))
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "1.1.0", modelInfo.ModelId.ArtifactVersion)
+	assert.Empty(t, modelInfo.ArtifactChecksum)
+}
+
+func TestCommitUploadChecksumMismatch(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 21, Checksum: fakeZipChecksum}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/commitUpload/test-model/1/1.1.0",
+		strings.NewReader(`{"checksum":"`+strings.Repeat("0", 64)+`"}`))
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	iDBMockInst.AssertNotCalled(t, "CommitArtifactVersion", mock.Anything)
+}
+
+func TestCommitUploadNotAllocatedArtifactVersion(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	iDBMockInst.On("CommitArtifactVersion", "1.1.0").Return(nil, db.ErrArtifactVersionConflict)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 21}, nil)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/commitUpload/test-model/1/1.1.0", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+}
+
+func TestCommitUploadMissingObject(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(registeredModel("1.0.0"), nil)
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{}, core.ErrObjectNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/ai-ml-model-registration/v1/commitUpload/test-model/1/1.1.0", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+}
+
+func TestPresignDownloadSuccess(t *testing.T) {
+	os.Setenv("LOG_FILE_NAME", "testing")
+	os.Setenv("MODEL_FILE_POSTFIX", ".zip")
+	dbMgrMockInst := new(mme_mocks.DbMgrMock)
+	dbMgrMockInst.On("HeadBucketObject").Return(core.ObjectInfo{Size: 21}, nil)
+	dbMgrMockInst.On("PresignDownload", "test-model_1_1.0.0.zip").Return("https://s3/presigned", nil)
+	iDBMockInst := new(mme_mocks.IDBMock)
+	iDBMockInst.On("GetModelInfoByNameAndVer").Return(nil, db.ErrNotFound)
+	router := routers.InitRouter(apis.NewMmeApiHandler(dbMgrMockInst, iDBMockInst))
+	responseRecorder := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/ai-ml-model-registration/v1/presignedDownload/test-model/1/1.0.0", nil)
+	router.ServeHTTP(responseRecorder, req)
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	var response models.PresignedUrlResponse
+	assert.NoError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+	assert.Equal(t, "https://s3/presigned", response.Url)
+	assert.Equal(t, http.MethodGet, response.Method)
+}
@@ -2,9 +2,36 @@ package config
 
 import "encoding/json"
 
+// Supported values of STORAGE_BACKEND, an empty value selects S3
+const (
+	STORAGE_BACKEND_S3    = "s3"
+	STORAGE_BACKEND_LOCAL = "local"
+)
+
+// Supported values of DB_DRIVER, an empty value selects Postgres
+const (
+	DB_DRIVER_POSTGRES = "postgres"
+	DB_DRIVER_SQLITE   = "sqlite"
+)
+
+// Supported values of MODEL_DELETE_POLICY, deciding what happens to the stored
+// artifacts of a registration being deleted, an empty value selects orphan
+const (
+	DELETE_POLICY_REFUSE  = "refuse"
+	DELETE_POLICY_CASCADE = "cascade"
+	DELETE_POLICY_ORPHAN  = "orphan"
+)
+
 type AppConfigData struct {
-	MMES_URL      string `json:"mmes_url"`
-	LOG_FILE_NAME string `json:"log_file_name"`
+	MMES_URL            string `json:"mmes_url"`
+	LOG_FILE_NAME       string `json:"log_file_name"`
+	MODEL_DELETE_POLICY string `json:"model_delete_policy"`
+	// how long deleted registrations are kept before being purged, 720h when empty
+	MODEL_DELETE_RETENTION string `json:"model_delete_retention"`
+	// how long reading a whole request and writing a whole response may take, no limit when
+	// empty so that large artifacts can be transferred
+	HTTP_READ_TIMEOUT  string `json:"http_read_timeout"`
+	HTTP_WRITE_TIMEOUT string `json:"http_write_timeout"`
 }
 
 func (a AppConfigData) String() string {
@@ -24,6 +51,12 @@ type DBConfigData struct {
 	PG_HOST            string `json:"pg_host"`
 	PG_PORT            string `json:"pg_port"`
 	PG_DBNAME          string `json:"pg_dbname"`
+	DB_DRIVER          string `json:"db_driver"`
+	// file of the SQLite database, or :memory:, when DB_DRIVER is sqlite
+	SQLITE_PATH        string `json:"sqlite_path"`
+	STORAGE_BACKEND    string `json:"storage_backend"`
+	LOCAL_STORAGE_ROOT string `json:"local_storage_root"`
+	PRESIGNED_URL_TTL  string `json:"presigned_url_ttl"`
 }
 
 func (d DBConfigData) String() string {
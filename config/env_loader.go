@@ -22,12 +22,21 @@ const (
 	ENV_KEY_DB_PG_USER            = "PG_USER"
 	ENV_KEY_DB_PG_DBNAME          = "PG_DBNAME"
 	ENV_KEY_DB_PG_PORT            = "PG_PORT"
+	ENV_KEY_DB_DRIVER             = "DB_DRIVER"
+	ENV_KEY_DB_SQLITE_PATH        = "SQLITE_PATH"
+	ENV_KEY_DB_STORAGE_BACKEND    = "STORAGE_BACKEND"
+	ENV_KEY_DB_LOCAL_STORAGE_ROOT = "LOCAL_STORAGE_ROOT"
+	ENV_KEY_DB_PRESIGNED_URL_TTL  = "PRESIGNED_URL_TTL"
 )
 
 // APP ENV KEY
 const (
-	ENV_KEY_APP_MMES_URL      = "MMES_URL"
-	ENV_KEY_APP_LOG_FILE_NAME = "LOG_FILE_NAME"
+	ENV_KEY_APP_MMES_URL               = "MMES_URL"
+	ENV_KEY_APP_LOG_FILE_NAME          = "LOG_FILE_NAME"
+	ENV_KEY_APP_MODEL_DELETE_POLICY    = "MODEL_DELETE_POLICY"
+	ENV_KEY_APP_MODEL_DELETE_RETENTION = "MODEL_DELETE_RETENTION"
+	ENV_KEY_APP_HTTP_READ_TIMEOUT      = "HTTP_READ_TIMEOUT"
+	ENV_KEY_APP_HTTP_WRITE_TIMEOUT     = "HTTP_WRITE_TIMEOUT"
 )
 
 type DefaultEnvData map[string]string
@@ -82,9 +91,18 @@ func (e *envDataLoader) dbDataLoad(c *configManager) {
 	c.DB.PG_PASSWORD = viper.GetString(ENV_KEY_DB_PG_PASSWORD)
 	c.DB.PG_DBNAME = viper.GetString(ENV_KEY_DB_PG_DBNAME)
 	c.DB.PG_PORT = viper.GetString(ENV_KEY_DB_PG_PORT)
+	c.DB.DB_DRIVER = viper.GetString(ENV_KEY_DB_DRIVER)
+	c.DB.SQLITE_PATH = viper.GetString(ENV_KEY_DB_SQLITE_PATH)
+	c.DB.STORAGE_BACKEND = viper.GetString(ENV_KEY_DB_STORAGE_BACKEND)
+	c.DB.LOCAL_STORAGE_ROOT = viper.GetString(ENV_KEY_DB_LOCAL_STORAGE_ROOT)
+	c.DB.PRESIGNED_URL_TTL = viper.GetString(ENV_KEY_DB_PRESIGNED_URL_TTL)
 }
 
 func (e *envDataLoader) appDataLoad(c *configManager) {
 	c.App.MMES_URL = viper.GetString(ENV_KEY_APP_MMES_URL)
 	c.App.LOG_FILE_NAME = viper.GetString(ENV_KEY_APP_LOG_FILE_NAME)
+	c.App.MODEL_DELETE_POLICY = viper.GetString(ENV_KEY_APP_MODEL_DELETE_POLICY)
+	c.App.MODEL_DELETE_RETENTION = viper.GetString(ENV_KEY_APP_MODEL_DELETE_RETENTION)
+	c.App.HTTP_READ_TIMEOUT = viper.GetString(ENV_KEY_APP_HTTP_READ_TIMEOUT)
+	c.App.HTTP_WRITE_TIMEOUT = viper.GetString(ENV_KEY_APP_HTTP_WRITE_TIMEOUT)
 }
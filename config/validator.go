@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -37,6 +38,30 @@ func (c *configDataValidator) validate(manager *configManager) error {
 		c.errs = append(c.errs, fmt.Errorf("mmes_url is not set/available or empty"))
 	}
 
+	switch manager.App.MODEL_DELETE_POLICY {
+	case "", DELETE_POLICY_REFUSE, DELETE_POLICY_CASCADE, DELETE_POLICY_ORPHAN:
+	default:
+		c.errs = append(c.errs, fmt.Errorf("model_delete_policy %q is not supported", manager.App.MODEL_DELETE_POLICY))
+	}
+
+	if manager.App.MODEL_DELETE_RETENTION != "" {
+		if retention, err := time.ParseDuration(manager.App.MODEL_DELETE_RETENTION); err != nil || retention < 0 {
+			c.errs = append(c.errs, fmt.Errorf("model_delete_retention %q is not a valid duration", manager.App.MODEL_DELETE_RETENTION))
+		}
+	}
+
+	if manager.App.HTTP_READ_TIMEOUT != "" {
+		if timeout, err := time.ParseDuration(manager.App.HTTP_READ_TIMEOUT); err != nil || timeout < 0 {
+			c.errs = append(c.errs, fmt.Errorf("http_read_timeout %q is not a valid duration", manager.App.HTTP_READ_TIMEOUT))
+		}
+	}
+
+	if manager.App.HTTP_WRITE_TIMEOUT != "" {
+		if timeout, err := time.ParseDuration(manager.App.HTTP_WRITE_TIMEOUT); err != nil || timeout < 0 {
+			c.errs = append(c.errs, fmt.Errorf("http_write_timeout %q is not a valid duration", manager.App.HTTP_WRITE_TIMEOUT))
+		}
+	}
+
 	if manager.DB.MODEL_FILE_POSTFIX == "" {
 		c.errs = append(c.errs, fmt.Errorf("model_file_postfix is not set/available or empty"))
 	}
@@ -45,6 +70,37 @@ func (c *configDataValidator) validate(manager *configManager) error {
 		c.errs = append(c.errs, fmt.Errorf("model_info_file_postfix is not set/available or empty"))
 	}
 
+	switch manager.DB.DB_DRIVER {
+	case "", DB_DRIVER_POSTGRES:
+	case DB_DRIVER_SQLITE:
+		if manager.DB.SQLITE_PATH == "" {
+			c.errs = append(c.errs, fmt.Errorf("sqlite_path is not set/available or empty"))
+		}
+	default:
+		c.errs = append(c.errs, fmt.Errorf("db_driver %q is not supported", manager.DB.DB_DRIVER))
+	}
+
+	switch manager.DB.STORAGE_BACKEND {
+	case "", STORAGE_BACKEND_S3:
+		c.validateS3(manager)
+	case STORAGE_BACKEND_LOCAL:
+		if manager.DB.LOCAL_STORAGE_ROOT == "" {
+			c.errs = append(c.errs, fmt.Errorf("local_storage_root is not set/available or empty"))
+		}
+	default:
+		c.errs = append(c.errs, fmt.Errorf("storage_backend %q is not supported", manager.DB.STORAGE_BACKEND))
+	}
+
+	if manager.DB.PRESIGNED_URL_TTL != "" {
+		if ttl, err := time.ParseDuration(manager.DB.PRESIGNED_URL_TTL); err != nil || ttl <= 0 {
+			c.errs = append(c.errs, fmt.Errorf("presigned_url_ttl %q is not a positive duration", manager.DB.PRESIGNED_URL_TTL))
+		}
+	}
+
+	return c.result()
+}
+
+func (c *configDataValidator) validateS3(manager *configManager) {
 	if manager.DB.S3_URL == "" {
 		c.errs = append(c.errs, fmt.Errorf("s3_url is not set/available or empty"))
 	}
@@ -60,6 +116,4 @@ func (c *configDataValidator) validate(manager *configManager) error {
 	if manager.DB.S3_REGION == "" {
 		c.errs = append(c.errs, fmt.Errorf("s3_region is not set/available or empty"))
 	}
-
-	return c.result()
 }
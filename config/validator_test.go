@@ -57,3 +57,202 @@ func TestValidateWhenFailedDBS3URL(t *testing.T) {
 	assert.ErrorIs(t, err, ErrInvalidConfigData)
 	assert.Equal(t, "", manager.DB.S3_URL)
 }
+
+func TestValidateWhenLocalStorageBackend(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.Nil(t, err)
+}
+
+func TestValidateWhenFailedLocalStorageRoot(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedStorageBackend(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    "ftp",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedPresignedUrlTTL(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+			PRESIGNED_URL_TTL:  "15",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedModelDeletePolicy(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:            "test",
+			LOG_FILE_NAME:       "test",
+			MODEL_DELETE_POLICY: "shred",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedModelDeleteRetention(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:               "test",
+			LOG_FILE_NAME:          "test",
+			MODEL_DELETE_RETENTION: "-1h",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedHTTPTimeout(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:           "test",
+			LOG_FILE_NAME:      "test",
+			HTTP_READ_TIMEOUT:  "10m",
+			HTTP_WRITE_TIMEOUT: "forever",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+	assert.ErrorContains(t, err, "http_write_timeout")
+	assert.NotContains(t, err.Error(), "http_read_timeout")
+}
+
+func TestValidateWhenSQLiteDriver(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+			DB_DRIVER:          DB_DRIVER_SQLITE,
+			SQLITE_PATH:        "/var/lib/mme/mme.db",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.Nil(t, err)
+}
+
+func TestValidateWhenFailedSQLitePath(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+			DB_DRIVER:          DB_DRIVER_SQLITE,
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
+
+func TestValidateWhenFailedDBDriver(t *testing.T) {
+	configDataValidator := NewConfigDataValidator()
+	manager := configManager{
+		App: AppConfigData{
+			MMES_URL:      "test",
+			LOG_FILE_NAME: "test",
+		},
+		DB: DBConfigData{
+			MODEL_FILE_POSTFIX: "test",
+			INFO_FILE_POSTFIX:  "test",
+			STORAGE_BACKEND:    STORAGE_BACKEND_LOCAL,
+			LOCAL_STORAGE_ROOT: "/var/lib/mme",
+			DB_DRIVER:          "mysql",
+		},
+	}
+
+	err := configDataValidator.validate(&manager)
+	assert.ErrorIs(t, err, ErrInvalidConfigData)
+}
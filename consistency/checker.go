@@ -0,0 +1,267 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package consistency
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+)
+
+const noArtifactVersion = "0.0.0"
+
+type Options struct {
+	// roll registrations whose current artifact is missing back to the newest stored one
+	Repair bool
+	// delete the orphaned objects from the storage
+	DeleteOrphans bool
+	// objects modified more recently are not reported as orphaned, they may be
+	// uploads which are not committed yet
+	GracePeriod time.Duration
+}
+
+// Checker cross-checks the registrations of the database against the artifacts of the storage
+type Checker struct {
+	dbMgr            core.DBMgr
+	iDB              db.IDB
+	modelFilePostfix string
+	now              func() time.Time
+}
+
+func NewChecker(dbMgr core.DBMgr, iDB db.IDB, modelFilePostfix string) *Checker {
+	return &Checker{
+		dbMgr:            dbMgr,
+		iDB:              iDB,
+		modelFilePostfix: modelFilePostfix,
+		now:              time.Now,
+	}
+}
+
+func modelKey(modelInfo *models.ModelRelatedInformation) string {
+	return modelInfo.ModelId.ModelName + "_" + modelInfo.ModelId.ModelVersion
+}
+
+// Run checks every registration and every bucket of the storage
+func (c *Checker) Run(options Options) (*models.ConsistencyReport, error) {
+	registrations, err := c.iDB.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	bucketNames, err := c.dbMgr.ListBucketNames()
+	if err != nil {
+		return nil, err
+	}
+
+	registrationsByBucket := map[string][]*models.ModelRelatedInformation{}
+	for i := range registrations {
+		bucketName := utils.ModelBucketName(registrations[i].ModelId.ModelName)
+		registrationsByBucket[bucketName] = append(registrationsByBucket[bucketName], &registrations[i])
+	}
+	for _, bucketName := range bucketNames {
+		if _, ok := registrationsByBucket[bucketName]; !ok {
+			registrationsByBucket[bucketName] = nil
+		}
+	}
+	buckets := make([]string, 0, len(registrationsByBucket))
+	for bucketName := range registrationsByBucket {
+		buckets = append(buckets, bucketName)
+	}
+	sort.Strings(buckets)
+
+	report := &models.ConsistencyReport{
+		MissingArtifacts: []models.MissingArtifact{},
+		OrphanedObjects:  []models.OrphanedObject{},
+	}
+	for _, bucketName := range buckets {
+		items, err := c.dbMgr.GetBucketItems(bucketName)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.checkBucket(bucketName, items, registrationsByBucket[bucketName], options, report); err != nil {
+			return nil, err
+		}
+	}
+	logging.INFO("Consistency check done", "models", report.CheckedModels, "objects", report.CheckedObjects,
+		"missing", len(report.MissingArtifacts), "orphaned", len(report.OrphanedObjects))
+	return report, nil
+}
+
+func (c *Checker) checkBucket(bucketName string, items []core.ObjectInfo, registrations []*models.ModelRelatedInformation, options Options, report *models.ConsistencyReport) error {
+	registered := map[string]*models.ModelRelatedInformation{}
+	histories := map[string][]models.ArtifactVersion{}
+	for _, modelInfo := range registrations {
+		registered[modelKey(modelInfo)] = modelInfo
+		history, err := c.iDB.ListArtifactVersions(modelInfo.Id)
+		if err != nil {
+			return err
+		}
+		histories[modelKey(modelInfo)] = history
+	}
+
+	stored := map[string][]string{}
+	graceLimit := c.now().Add(-options.GracePeriod)
+	for _, item := range items {
+		reason := ""
+		if utils.IsStagingObjectName(item.Name) {
+			reason = models.OrphanStaging
+		} else {
+			key, artifactVersion, ok := utils.SplitModelObjectName(item.Name, bucketName, c.modelFilePostfix)
+			if !ok {
+				continue
+			}
+			stored[key] = append(stored[key], artifactVersion)
+			reason = orphanReason(registered[key], histories[key], artifactVersion)
+		}
+		report.CheckedObjects++
+		if reason == "" || item.LastModified.After(graceLimit) {
+			continue
+		}
+		orphan := models.OrphanedObject{
+			Bucket:     bucketName,
+			ObjectName: item.Name,
+			Reason:     reason,
+		}
+		if options.DeleteOrphans {
+			orphan.Deleted = c.dbMgr.DeleteBucketObject(item.Name, bucketName)
+		}
+		report.OrphanedObjects = append(report.OrphanedObjects, orphan)
+	}
+
+	for _, modelInfo := range registrations {
+		report.CheckedModels++
+		storedVersions := stored[modelKey(modelInfo)]
+		history := histories[modelKey(modelInfo)]
+		recorded := map[string]models.ArtifactVersion{}
+		for _, artifact := range history {
+			recorded[artifact.Version] = artifact
+		}
+
+		artifactVersion := modelInfo.ModelId.ArtifactVersion
+		if artifactVersion != "" && artifactVersion != noArtifactVersion && !slices.Contains(storedVersions, artifactVersion) {
+			missing := c.missingArtifact(modelInfo, bucketName, artifactVersion)
+			if options.Repair {
+				missing.RepairedTo = c.rollback(modelInfo, missing.ObjectName, storedVersions, recorded)
+			}
+			report.MissingArtifacts = append(report.MissingArtifacts, missing)
+		}
+
+		// the previous artifacts of the history can still be downloaded, unless they are revoked
+		for _, artifact := range history {
+			if artifact.Version == artifactVersion || artifact.Status == models.ArtifactStatusRevoked || slices.Contains(storedVersions, artifact.Version) {
+				continue
+			}
+			missing := c.missingArtifact(modelInfo, bucketName, artifact.Version)
+			if options.Repair {
+				missing.Revoked = c.revoke(modelInfo, missing.ObjectName, artifact.Version)
+			}
+			report.MissingArtifacts = append(report.MissingArtifacts, missing)
+		}
+	}
+	return nil
+}
+
+func (c *Checker) missingArtifact(modelInfo *models.ModelRelatedInformation, bucketName string, artifactVersion string) models.MissingArtifact {
+	return models.MissingArtifact{
+		Id:              modelInfo.Id,
+		ModelName:       modelInfo.ModelId.ModelName,
+		ModelVersion:    modelInfo.ModelId.ModelVersion,
+		ArtifactVersion: artifactVersion,
+		Bucket:          bucketName,
+		ObjectName:      utils.ModelObjectName(modelInfo.ModelId.ModelName, modelInfo.ModelId.ModelVersion, artifactVersion, c.modelFilePostfix),
+	}
+}
+
+// Returns why the artifact is orphaned, "" when the registration refers to it. An artifact newer
+// than the current one is only uncommitted when its history doesn't record it either, the
+// registration may have been rolled back to an older artifact.
+func orphanReason(modelInfo *models.ModelRelatedInformation, history []models.ArtifactVersion, artifactVersion string) string {
+	if modelInfo == nil {
+		return models.OrphanUnregistered
+	}
+	if utils.CompareArtifactVersions(artifactVersion, modelInfo.ModelId.ArtifactVersion) <= 0 {
+		return ""
+	}
+	for _, artifact := range history {
+		if artifact.Version == artifactVersion {
+			return ""
+		}
+	}
+	return models.OrphanUncommitted
+}
+
+// Rolls the registration back to the newest stored artifact older than the missing one, which
+// isn't revoked, and revokes the missing one. Returns the artifact version it was rolled back to
+// or "" when it was left untouched.
+func (c *Checker) rollback(modelInfo *models.ModelRelatedInformation, objectName string, storedVersions []string, recorded map[string]models.ArtifactVersion) string {
+	target := noArtifactVersion
+	for _, artifactVersion := range storedVersions {
+		if recorded[artifactVersion].Status == models.ArtifactStatusRevoked {
+			continue
+		}
+		if utils.CompareArtifactVersions(artifactVersion, modelInfo.ModelId.ArtifactVersion) < 0 &&
+			utils.CompareArtifactVersions(artifactVersion, target) > 0 {
+			target = artifactVersion
+		}
+	}
+
+	// An upload may have completed since the listing, check again before touching the registration
+	current, err := c.iDB.GetModelInfoByNameAndVer(modelInfo.ModelId.ModelName, modelInfo.ModelId.ModelVersion)
+	if err != nil || current.ModelId.ArtifactVersion != modelInfo.ModelId.ArtifactVersion {
+		logging.WARN("Registration changed during the check, not repaired", "id", modelInfo.Id)
+		return ""
+	}
+	if _, err := c.dbMgr.HeadBucketObject(objectName, utils.ModelBucketName(modelInfo.ModelId.ModelName)); !errors.Is(err, core.ErrObjectNotFound) {
+		logging.WARN("Artifact is not reported missing anymore, not repaired", "object", objectName)
+		return ""
+	}
+
+	// the artifacts stored by the previous releases have no history, nor checksum
+	if err := c.iDB.SetCurrentArtifactVersion(current.ModelId.ModelName, current.ModelId.ModelVersion, target, recorded[target].Digest, current.RowVersion); err != nil {
+		logging.ERROR("Unable to repair the registration", "id", modelInfo.Id, "error", err)
+		return ""
+	}
+	logging.INFO("Registration rolled back", "id", modelInfo.Id, "artifactVersion", target)
+	if _, ok := recorded[modelInfo.ModelId.ArtifactVersion]; ok {
+		if _, err := c.iDB.SetArtifactVersionStatus(modelInfo.Id, modelInfo.ModelId.ArtifactVersion, models.ArtifactStatusRevoked); err != nil {
+			logging.ERROR("Unable to revoke the missing artifact", "id", modelInfo.Id, "artifactVersion", modelInfo.ModelId.ArtifactVersion, "error", err)
+		}
+	}
+	return target
+}
+
+// Revokes a previous artifact version whose object is missing, so it isn't served anymore.
+// Returns false when it was left untouched.
+func (c *Checker) revoke(modelInfo *models.ModelRelatedInformation, objectName string, artifactVersion string) bool {
+	if _, err := c.dbMgr.HeadBucketObject(objectName, utils.ModelBucketName(modelInfo.ModelId.ModelName)); !errors.Is(err, core.ErrObjectNotFound) {
+		logging.WARN("Artifact is not reported missing anymore, not repaired", "object", objectName)
+		return false
+	}
+	if _, err := c.iDB.SetArtifactVersionStatus(modelInfo.Id, artifactVersion, models.ArtifactStatusRevoked); err != nil {
+		logging.ERROR("Unable to revoke the missing artifact", "id", modelInfo.Id, "artifactVersion", artifactVersion, "error", err)
+		return false
+	}
+	logging.INFO("Missing artifact revoked", "id", modelInfo.Id, "artifactVersion", artifactVersion)
+	return true
+}
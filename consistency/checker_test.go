@@ -0,0 +1,239 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package consistency
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const postfix = "_model.zip"
+
+func newTestChecker(t *testing.T) (*Checker, *db.ModelInfoRepository, *core.LocalManager) {
+	t.Helper()
+	d, err := db.Open(config.DBConfigData{DB_DRIVER: config.DB_DRIVER_SQLITE, SQLITE_PATH: "file:" + t.Name() + "?mode=memory&cache=shared"})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.Migrate(d); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	repo := db.NewModelInfoRepository(d)
+	storage := &core.LocalManager{Root: t.TempDir()}
+	checker := NewChecker(storage, repo, postfix)
+	// every object is older than the grace period
+	checker.now = func() time.Time { return time.Now().Add(time.Hour) }
+	return checker, repo, storage
+}
+
+func register(t *testing.T, repo *db.ModelInfoRepository, name string, version string, artifactVersion string) {
+	t.Helper()
+	err := repo.Create(models.ModelRelatedInformation{
+		ModelId:     models.ModelID{ModelName: name, ModelVersion: version, ArtifactVersion: artifactVersion},
+		Description: "test",
+		ModelInformation: models.ModelInformation{
+			Metadata:       models.Metadata{Author: "tester"},
+			InputDataType:  "csv",
+			OutputDataType: "json",
+		},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+}
+
+func store(t *testing.T, storage *core.LocalManager, bucketName string, objectName string) {
+	t.Helper()
+	if err := storage.UploadFile(strings.NewReader("artifact"), objectName, bucketName); err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+}
+
+func TestCheckerConsistent(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	register(t, repo, "Qoe", "1", "1.1.0")
+	register(t, repo, "kpi", "1", "0.0.0")
+	store(t, storage, "qoe", "Qoe_1_1.0.0"+postfix)
+	store(t, storage, "qoe", "Qoe_1_1.1.0"+postfix)
+	store(t, storage, "qoe", "Qoe_info.json")
+
+	report, err := checker.Run(Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.CheckedModels)
+	assert.Equal(t, 2, report.CheckedObjects)
+	assert.Empty(t, report.MissingArtifacts)
+	assert.Empty(t, report.OrphanedObjects)
+	assert.Equal(t, 0, report.Unresolved())
+}
+
+func TestCheckerReportsOnly(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	register(t, repo, "qoe", "1", "1.2.0")
+	store(t, storage, "qoe", "qoe_1_1.0.0"+postfix)
+	store(t, storage, "qoe", "qoe_2_1.0.0"+postfix)
+
+	report, err := checker.Run(Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.MissingArtifact{{
+		Id:              report.MissingArtifacts[0].Id,
+		ModelName:       "qoe",
+		ModelVersion:    "1",
+		ArtifactVersion: "1.2.0",
+		Bucket:          "qoe",
+		ObjectName:      "qoe_1_1.2.0" + postfix,
+	}}, report.MissingArtifacts)
+	assert.Equal(t, []models.OrphanedObject{
+		{Bucket: "qoe", ObjectName: "qoe_2_1.0.0" + postfix, Reason: models.OrphanUnregistered},
+	}, report.OrphanedObjects)
+	assert.Equal(t, 2, report.Unresolved())
+
+	modelInfo, err := repo.GetModelInfoByNameAndVer("qoe", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.0", modelInfo.ModelId.ArtifactVersion)
+	assert.FileExists(t, filepath.Join(storage.Root, "qoe", "qoe_2_1.0.0"+postfix))
+}
+
+func TestCheckerRepairsAndDeletes(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	register(t, repo, "qoe", "1", "1.2.0")
+	register(t, repo, "kpi", "1", "1.0.0")
+	store(t, storage, "qoe", "qoe_1_1.0.0"+postfix)
+	store(t, storage, "kpi", "kpi_1_1.0.0"+postfix)
+	store(t, storage, "kpi", "kpi_1_1.1.0"+postfix)
+	store(t, storage, "unknown", "unknown_1_1.0.0"+postfix)
+	store(t, storage, "kpi", "~staging~42~kpi_1_1.1.0"+postfix)
+
+	report, err := checker.Run(Options{Repair: true, DeleteOrphans: true})
+	assert.NoError(t, err)
+	assert.Len(t, report.MissingArtifacts, 1)
+	assert.Equal(t, "1.0.0", report.MissingArtifacts[0].RepairedTo)
+	assert.Equal(t, []models.OrphanedObject{
+		{Bucket: "kpi", ObjectName: "kpi_1_1.1.0" + postfix, Reason: models.OrphanUncommitted, Deleted: true},
+		{Bucket: "kpi", ObjectName: "~staging~42~kpi_1_1.1.0" + postfix, Reason: models.OrphanStaging, Deleted: true},
+		{Bucket: "unknown", ObjectName: "unknown_1_1.0.0" + postfix, Reason: models.OrphanUnregistered, Deleted: true},
+	}, report.OrphanedObjects)
+	assert.Equal(t, 0, report.Unresolved())
+
+	modelInfo, err := repo.GetModelInfoByNameAndVer("qoe", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", modelInfo.ModelId.ArtifactVersion)
+	_, err = os.Stat(filepath.Join(storage.Root, "kpi", "kpi_1_1.1.0"+postfix))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	report, err = checker.Run(Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.Unresolved())
+}
+
+func commit(t *testing.T, repo *db.ModelInfoRepository, name string, version string, digest string) string {
+	t.Helper()
+	artifactVersion, err := repo.AllocateArtifactVersion(name, version, "")
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+	if _, err := repo.CommitArtifactVersion(name, version, models.ArtifactVersion{Version: artifactVersion, Digest: digest}, nil); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	return artifactVersion
+}
+
+func TestCheckerRepairsHistory(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	register(t, repo, "qoe", "1", "0.0.0")
+	commit(t, repo, "qoe", "1", "aaaa")
+	commit(t, repo, "qoe", "1", "bbbb")
+	commit(t, repo, "qoe", "1", "cccc")
+	// 1.0.0 and the current 1.2.0 are gone
+	store(t, storage, "qoe", "qoe_1_1.1.0"+postfix)
+
+	report, err := checker.Run(Options{})
+	assert.NoError(t, err)
+	assert.Len(t, report.MissingArtifacts, 2)
+	assert.Equal(t, "1.2.0", report.MissingArtifacts[0].ArtifactVersion)
+	assert.Equal(t, "1.0.0", report.MissingArtifacts[1].ArtifactVersion)
+	assert.Equal(t, 2, report.Unresolved())
+
+	report, err = checker.Run(Options{Repair: true})
+	assert.NoError(t, err)
+	assert.Len(t, report.MissingArtifacts, 2)
+	assert.Equal(t, "1.1.0", report.MissingArtifacts[0].RepairedTo)
+	assert.True(t, report.MissingArtifacts[1].Revoked)
+	assert.Equal(t, 0, report.Unresolved())
+
+	modelInfo, err := repo.GetModelInfoByNameAndVer("qoe", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0", modelInfo.ModelId.ArtifactVersion)
+	// the checksum of the artifact rolled back to is kept
+	assert.Equal(t, "bbbb", modelInfo.ArtifactChecksum)
+	for _, artifactVersion := range []string{"1.0.0", "1.2.0"} {
+		artifact, err := repo.GetArtifactVersion(modelInfo.Id, artifactVersion)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ArtifactStatusRevoked, artifact.Status, artifactVersion)
+	}
+
+	report, err = checker.Run(Options{})
+	assert.NoError(t, err)
+	assert.Empty(t, report.MissingArtifacts)
+}
+
+// A registration rolled back to an older artifact keeps the newer ones it committed
+func TestCheckerKeepsCommittedAfterRollback(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	register(t, repo, "qoe", "1", "0.0.0")
+	commit(t, repo, "qoe", "1", "aaaa")
+	commit(t, repo, "qoe", "1", "bbbb")
+	store(t, storage, "qoe", "qoe_1_1.0.0"+postfix)
+	store(t, storage, "qoe", "qoe_1_1.1.0"+postfix)
+	// never committed
+	store(t, storage, "qoe", "qoe_1_1.2.0"+postfix)
+	assert.NoError(t, repo.SetCurrentArtifactVersion("qoe", "1", "1.0.0", "aaaa", 0))
+
+	report, err := checker.Run(Options{DeleteOrphans: true})
+	assert.NoError(t, err)
+	assert.Empty(t, report.MissingArtifacts)
+	assert.Len(t, report.OrphanedObjects, 1)
+	assert.Equal(t, "qoe_1_1.2.0"+postfix, report.OrphanedObjects[0].ObjectName)
+	assert.Equal(t, models.OrphanUncommitted, report.OrphanedObjects[0].Reason)
+	assert.True(t, report.OrphanedObjects[0].Deleted)
+
+	_, err = storage.HeadBucketObject("qoe_1_1.1.0"+postfix, "qoe")
+	assert.NoError(t, err)
+}
+
+func TestCheckerGracePeriod(t *testing.T) {
+	checker, repo, storage := newTestChecker(t)
+	checker.now = time.Now
+	register(t, repo, "qoe", "1", "1.0.0")
+	store(t, storage, "qoe", "qoe_1_1.0.0"+postfix)
+	// presigned upload not committed yet
+	store(t, storage, "qoe", "qoe_1_1.1.0"+postfix)
+
+	report, err := checker.Run(Options{DeleteOrphans: true, GracePeriod: time.Hour})
+	assert.NoError(t, err)
+	assert.Empty(t, report.OrphanedObjects)
+	assert.FileExists(t, filepath.Join(storage.Root, "qoe", "qoe_1_1.1.0"+postfix))
+}
@@ -0,0 +1,344 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+)
+
+/*
+LocalManager is a DBMgr backed by the local filesystem, it is meant for
+deployments without an object store (air-gapped sites, development).
+Every bucket is a directory under Root and every object is a file inside
+its bucket directory.
+*/
+type LocalManager struct {
+	Root string
+}
+
+func newLocalManager(root string) *LocalManager {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		panic(err)
+	}
+	return &LocalManager{Root: root}
+}
+
+// Bucket and object names arrive from request paths, so they must not be
+// able to escape the storage root
+func validateLocalName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid name: %q", name)
+	}
+	return nil
+}
+
+func (localManager *LocalManager) bucketPath(bucketName string) (string, error) {
+	if err := validateLocalName(bucketName); err != nil {
+		return "", err
+	}
+	return filepath.Join(localManager.Root, bucketName), nil
+}
+
+func (localManager *LocalManager) objectPath(objectName string, bucketName string) (string, error) {
+	bucketPath, err := localManager.bucketPath(bucketName)
+	if err != nil {
+		return "", err
+	}
+	if err := validateLocalName(objectName); err != nil {
+		return "", err
+	}
+	return filepath.Join(bucketPath, objectName), nil
+}
+
+func (localManager *LocalManager) CreateBucket(bucketName string) (err error) {
+	bucketPath, err := localManager.bucketPath(bucketName)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(bucketPath, 0o755); err != nil {
+		logging.ERROR("error", "localError:", err)
+		return err
+	}
+	logging.INFO("Bucket created : " + bucketName)
+	return nil
+}
+
+func (localManager *LocalManager) GetBucketObject(objectName string, bucketName string) (*BucketObjectReader, error) {
+	objectPath, err := localManager.objectPath(objectName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(objectPath)
+	if err != nil {
+		logging.ERROR("Error, can't get fetch object..")
+		return nil, translateLocalError(err)
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		logging.ERROR("Error, can't get fetch object..")
+		return nil, err
+	}
+	logging.INFO("Successfully retrieved object...")
+	return &BucketObjectReader{
+		ReadCloser: file,
+		ObjectInfo: ObjectInfo{
+			Name:         objectName,
+			Size:         fileInfo.Size(),
+			LastModified: fileInfo.ModTime(),
+		},
+	}, nil
+}
+
+// Streams length bytes of the object starting at offset
+func (localManager *LocalManager) GetBucketObjectRange(objectName string, bucketName string, offset int64, length int64) (*BucketObjectReader, error) {
+	object, err := localManager.GetBucketObject(objectName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	file := object.ReadCloser.(*os.File)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if offset+length > object.Size {
+		length = object.Size - offset
+	}
+	object.ReadCloser = struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(file, length), file}
+	object.Size = length
+	return object, nil
+}
+
+// Returns the size and modification time of the object without opening it
+func (localManager *LocalManager) HeadBucketObject(objectName string, bucketName string) (ObjectInfo, error) {
+	objectPath, err := localManager.objectPath(objectName, bucketName)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	fileInfo, err := os.Stat(objectPath)
+	if err != nil {
+		logging.ERROR("Error, can't get head of object..")
+		return ObjectInfo{}, translateLocalError(err)
+	}
+	return ObjectInfo{
+		Name:         objectName,
+		Size:         fileInfo.Size(),
+		LastModified: fileInfo.ModTime(),
+	}, nil
+}
+
+// The objects are only reachable through the service, there is nothing to presign
+func (localManager *LocalManager) SupportsPresign() bool {
+	return false
+}
+
+func (localManager *LocalManager) PresignUpload(objectName string, bucketName string, checksum string, expiry time.Duration) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (localManager *LocalManager) PresignDownload(objectName string, bucketName string, expiry time.Duration) (string, error) {
+	return "", ErrNotSupported
+}
+
+func translateLocalError(err error) error {
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrObjectNotFound, err.Error())
+	}
+	return err
+}
+
+// Deletes the object and then the bucket, like S3 the bucket is only
+// removed when no other object is left in it
+func (localManager *LocalManager) DeleteBucket(objectName string, bucketName string) {
+	success := localManager.DeleteBucketObject(objectName, bucketName)
+	if !success {
+		logging.ERROR("Failed to delete the Bucket ...")
+		return
+	}
+	bucketPath, err := localManager.bucketPath(bucketName)
+	if err != nil {
+		logging.ERROR("Failed to delete the Bucket ...", "error", err)
+		return
+	}
+	if err := os.Remove(bucketPath); err != nil {
+		logging.ERROR("Failed to delete the Bucket ...", "error", err)
+		return
+	}
+	logging.INFO("Bucket deleted successfully..")
+}
+
+func (localManager *LocalManager) DeleteBucketObject(objectName string, bucketName string) bool {
+	objectPath, err := localManager.objectPath(objectName, bucketName)
+	if err != nil {
+		logging.WARN("Can not delete the bucket object", "error", err)
+		return false
+	}
+	if err := os.Remove(objectPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logging.WARN("Can not delete the bucket object", "error", err)
+		return false
+	}
+	logging.INFO("Object deleted successfully..")
+	return true
+}
+
+// The rename is atomic, the object is either at its old or at its new name
+func (localManager *LocalManager) MoveBucketObject(srcObjectName string, dstObjectName string, bucketName string) error {
+	srcPath, err := localManager.objectPath(srcObjectName, bucketName)
+	if err != nil {
+		return err
+	}
+	dstPath, err := localManager.objectPath(dstObjectName, bucketName)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(srcPath, dstPath); err != nil {
+		logging.ERROR("Can not move "+srcObjectName+" to "+dstObjectName, "error", err)
+		return translateLocalError(err)
+	}
+	return nil
+}
+
+// The file is written next to its final name and renamed into place, so a
+// reader never observes a partially written object
+func (localManager *LocalManager) UploadFile(data io.Reader, file_name string, bucketName string) error {
+	if err := localManager.CreateBucket(bucketName); err != nil {
+		logging.DEBUG(fmt.Sprintf("unable to create bucket for uploading-model, Error : %v", err))
+		return fmt.Errorf("unable to create bucket for uploading-model, Error : %v", err)
+	}
+	objectPath, err := localManager.objectPath(file_name, bucketName)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(objectPath), "."+file_name+".*")
+	if err != nil {
+		logging.ERROR("Error in uploading file to bucket ", err)
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := io.Copy(tmpFile, data); err != nil {
+		tmpFile.Close()
+		logging.ERROR("Error in uploading file to bucket ", err)
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		logging.ERROR("Error in uploading file to bucket ", err)
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), objectPath); err != nil {
+		logging.ERROR("Error in uploading file to bucket ", err)
+		return err
+	}
+	logging.INFO("File uploaded to bucket " + bucketName)
+	return nil
+}
+
+func (localManager *LocalManager) ListBucket(bucketObjPostfix string) ([]Bucket, error) {
+	entries, err := os.ReadDir(localManager.Root)
+	if err != nil {
+		logging.ERROR("Can't get bucket list in local storage ", err)
+		return []Bucket{}, err
+	}
+
+	BucketList := []Bucket{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		bucketObject, err := readBucketObject(localManager, entry.Name()+bucketObjPostfix, entry.Name())
+		if err != nil {
+			logging.ERROR("Unable to list bucketname ", entry.Name(), ": Error : ", err.Error())
+			continue
+		}
+		if len(bucketObject) == 0 {
+			continue
+		}
+
+		BucketList = append(BucketList, Bucket{
+			Name:   entry.Name(),
+			Object: bucketObject,
+		})
+	}
+
+	return BucketList, nil
+}
+
+// Return the names of all the buckets of the storage
+func (localManager *LocalManager) ListBucketNames() ([]string, error) {
+	entries, err := os.ReadDir(localManager.Root)
+	if err != nil {
+		logging.ERROR("Can't get bucket list in local storage ", err)
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Return list of objects in the bucket, a missing bucket has no objects
+func (localManager *LocalManager) GetBucketItems(bucketName string) ([]ObjectInfo, error) {
+	bucketPath, err := localManager.bucketPath(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(bucketPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []ObjectInfo{}, nil
+		}
+		logging.ERROR("Can't list objects of bucket "+bucketName, "error", err)
+		return nil, err
+	}
+
+	items := []ObjectInfo{}
+	for _, entry := range entries {
+		// hidden files are uploads in progress
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			// removed since the directory was read
+			continue
+		}
+		items = append(items, ObjectInfo{
+			Name:         entry.Name(),
+			Size:         fileInfo.Size(),
+			LastModified: fileInfo.ModTime(),
+		})
+	}
+	return items, nil
+}
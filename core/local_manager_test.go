@@ -0,0 +1,170 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package core
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalManagerUploadAndGet(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	err := localManager.UploadFile(strings.NewReader("model content"), "qoe_1_1.0.0_model.zip", "qoe")
+	assert.NoError(t, err)
+
+	object, err := localManager.GetBucketObject("qoe_1_1.0.0_model.zip", "qoe")
+	assert.NoError(t, err)
+	defer object.Close()
+	assert.Equal(t, int64(len("model content")), object.Size)
+	assert.Equal(t, "qoe_1_1.0.0_model.zip", object.Name)
+	content, err := io.ReadAll(object)
+	assert.NoError(t, err)
+	assert.Equal(t, "model content", string(content))
+
+	_, err = localManager.GetBucketObject("missing_model.zip", "qoe")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocalManagerRangeAndHead(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("0123456789"), "object", "bucket"))
+
+	info, err := localManager.HeadBucketObject("object", "bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), info.Size)
+	assert.False(t, info.LastModified.IsZero())
+
+	object, err := localManager.GetBucketObjectRange("object", "bucket", 3, 4)
+	assert.NoError(t, err)
+	defer object.Close()
+	assert.Equal(t, int64(4), object.Size)
+	content, err := io.ReadAll(object)
+	assert.NoError(t, err)
+	assert.Equal(t, "3456", string(content))
+
+	_, err = localManager.HeadBucketObject("missing", "bucket")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocalManagerUploadOverwrites(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("first"), "object", "bucket"))
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("second"), "object", "bucket"))
+
+	object, err := readBucketObject(localManager, "object", "bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(object))
+
+	entries, err := os.ReadDir(filepath.Join(localManager.Root, "bucket"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestLocalManagerRejectsPathTraversal(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	assert.Error(t, localManager.UploadFile(strings.NewReader("x"), "../escape", "bucket"))
+	assert.Error(t, localManager.CreateBucket(".."))
+	_, err := localManager.GetBucketObject("object", "../bucket")
+	assert.Error(t, err)
+}
+
+func TestLocalManagerListBucket(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("info-a"), "a_info.json", "a"))
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("info-b"), "b_info.json", "b"))
+	assert.NoError(t, localManager.CreateBucket("empty"))
+
+	buckets, err := localManager.ListBucket("_info.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []Bucket{
+		{Name: "a", Object: BucketObject("info-a")},
+		{Name: "b", Object: BucketObject("info-b")},
+	}, buckets)
+
+	names, err := localManager.ListBucketNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "empty"}, names)
+}
+
+func TestLocalManagerDelete(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("1"), "object1", "bucket"))
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("2"), "object2", "bucket"))
+
+	assert.True(t, localManager.DeleteBucketObject("object1", "bucket"))
+	_, err := localManager.GetBucketObject("object1", "bucket")
+	assert.Error(t, err)
+
+	// bucket still holds object2, so only the object is removed
+	localManager.DeleteBucket("missing", "bucket")
+	assert.DirExists(t, filepath.Join(localManager.Root, "bucket"))
+
+	localManager.DeleteBucket("object2", "bucket")
+	assert.NoDirExists(t, filepath.Join(localManager.Root, "bucket"))
+}
+
+func TestLocalManagerGetBucketItems(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("12345"), "qoe_1_1.0.0_model.zip", "qoe"))
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("123"), "qoe_1_1.1.0_model.zip", "qoe"))
+	// leftover of an interrupted upload
+	assert.NoError(t, os.WriteFile(filepath.Join(localManager.Root, "qoe", ".qoe_1_1.2.0_model.zip.123"), []byte("1"), 0o644))
+
+	items, err := localManager.GetBucketItems("qoe")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "qoe_1_1.0.0_model.zip", items[0].Name)
+	assert.Equal(t, int64(5), items[0].Size)
+	assert.Equal(t, "qoe_1_1.1.0_model.zip", items[1].Name)
+
+	items, err = localManager.GetBucketItems("missing")
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestLocalManagerMoveBucketObject(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("new"), "~staging~1~qoe_1_1.1.0_model.zip", "qoe"))
+	assert.NoError(t, localManager.UploadFile(strings.NewReader("old"), "qoe_1_1.1.0_model.zip", "qoe"))
+
+	assert.NoError(t, localManager.MoveBucketObject("~staging~1~qoe_1_1.1.0_model.zip", "qoe_1_1.1.0_model.zip", "qoe"))
+	content, err := os.ReadFile(filepath.Join(localManager.Root, "qoe", "qoe_1_1.1.0_model.zip"))
+	assert.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+
+	err = localManager.MoveBucketObject("~staging~1~qoe_1_1.1.0_model.zip", "qoe_1_1.1.0_model.zip", "qoe")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocalManagerPresignNotSupported(t *testing.T) {
+	localManager := newLocalManager(t.TempDir())
+
+	assert.False(t, localManager.SupportsPresign())
+	_, err := localManager.PresignUpload("qoe_1_1.0.0_model.zip", "qoe", "", 0)
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
@@ -1,8 +1,37 @@
 package core
 
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	// Returned by DBMgr implementations when the requested object or its bucket doesn't exist
+	ErrObjectNotFound = errors.New("object not found")
+	// Returned by DBMgr implementations for operations their storage can't provide
+	ErrNotSupported = errors.New("operation not supported by the storage backend")
+)
+
 type BucketObject []byte
 
 type Bucket struct {
 	Name   string
 	Object BucketObject
 }
+
+// ObjectInfo describes a stored object without its content
+type ObjectInfo struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+	// hex encoded SHA-256 verified by the storage when the object was written, empty when unknown
+	Checksum string
+}
+
+// BucketObjectReader streams the content of a stored object,
+// the caller is responsible for closing it
+type BucketObjectReader struct {
+	io.ReadCloser
+	ObjectInfo
+}
@@ -18,24 +18,39 @@ limitations under the License.
 package core
 
 import (
-	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"time"
 
 	"sync"
 
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+	s3upload "github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
 var Lock = &sync.Mutex{}
-var s3MgrInstance *S3Manager
+var dbMgrInstance DBMgr
+
+// Size of the parts used for multipart uploads to S3
+const uploadPartSize = 16 * 1024 * 1024
+
+// CopyObject is limited to 5GB, larger objects are copied part by part
+const (
+	maxCopyObjectSize = 5 * 1024 * 1024 * 1024
+	copyPartSize      = 512 * 1024 * 1024
+)
 
 type S3Manager struct {
 	//S3Client has s3 endpoint connection pointer,
@@ -46,26 +61,41 @@ type S3Manager struct {
 
 type DBMgr interface {
 	CreateBucket(bucketName string) (err error)
-	GetBucketObject(objectName string, bucketName string) (BucketObject, error)
-	DeleteBucket(client *s3.S3, objectName string, bucketName string)
-	DeleteBucketObject(client *s3.S3, objectName string, bucketName string) bool
-	UploadFile(dataBytes []byte, file_name string, bucketName string) error
+	GetBucketObject(objectName string, bucketName string) (*BucketObjectReader, error)
+	GetBucketObjectRange(objectName string, bucketName string, offset int64, length int64) (*BucketObjectReader, error)
+	HeadBucketObject(objectName string, bucketName string) (ObjectInfo, error)
+	SupportsPresign() bool
+	PresignUpload(objectName string, bucketName string, checksum string, expiry time.Duration) (string, error)
+	PresignDownload(objectName string, bucketName string, expiry time.Duration) (string, error)
+	DeleteBucket(objectName string, bucketName string)
+	DeleteBucketObject(objectName string, bucketName string) bool
+	MoveBucketObject(srcObjectName string, dstObjectName string, bucketName string) error
+	UploadFile(data io.Reader, file_name string, bucketName string) error
 	ListBucket(bucketObjPostfix string) ([]Bucket, error)
-	GetBucketItems(bucketName string)
+	ListBucketNames() ([]string, error)
+	GetBucketItems(bucketName string) ([]ObjectInfo, error)
 }
 
-// Singleton for S3Manager
+// Singleton for DBMgr, the implementation is selected by the
+// STORAGE_BACKEND config, S3Manager is used when it is not set
 func GetDBManagerInstance() DBMgr {
 	Lock.Lock()
 	defer Lock.Unlock()
 
-	if s3MgrInstance == nil {
-		logging.INFO("Creating single instance for S3Manager")
-		s3MgrInstance = newS3Manager()
+	if dbMgrInstance == nil {
+		dbConfig := config.GetConfigManager().DB
+		switch dbConfig.STORAGE_BACKEND {
+		case config.STORAGE_BACKEND_LOCAL:
+			logging.INFO("Creating single instance for LocalManager")
+			dbMgrInstance = newLocalManager(dbConfig.LOCAL_STORAGE_ROOT)
+		default:
+			logging.INFO("Creating single instance for S3Manager")
+			dbMgrInstance = newS3Manager()
+		}
 	} else {
-		logging.WARN("S3Manager instance already exists")
+		logging.WARN("DBMgr instance already exists")
 	}
-	return s3MgrInstance
+	return dbMgrInstance
 }
 
 /*
@@ -114,10 +144,8 @@ func (s3manager *S3Manager) CreateBucket(bucketName string) (err error) {
 
 // objectName : Name of file/object under given bucket
 // bucketName : Name of s3 bucket
-// TODO: Return error
-func (s3manager *S3Manager) GetBucketObject(objectName string, bucketName string) (BucketObject, error) {
-
-	var response []byte
+// The returned reader streams the object body and must be closed by the caller
+func (s3manager *S3Manager) GetBucketObject(objectName string, bucketName string) (*BucketObjectReader, error) {
 	getInputs := &s3.GetObjectInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectName),
@@ -125,25 +153,136 @@ func (s3manager *S3Manager) GetBucketObject(objectName string, bucketName string
 	result, err := s3manager.S3Client.GetObject(getInputs)
 	if err != nil {
 		logging.ERROR("Error, can't get fetch object..")
-		return response, err
+		return nil, translateS3Error(err)
 	}
-	defer result.Body.Close()
 	logging.INFO("Successfully retrieved object...")
-	response, err = io.ReadAll(result.Body)
+	return &BucketObjectReader{
+		ReadCloser: result.Body,
+		ObjectInfo: ObjectInfo{
+			Name:         objectName,
+			Size:         aws.Int64Value(result.ContentLength),
+			LastModified: aws.TimeValue(result.LastModified),
+		},
+	}, nil
+}
+
+// Streams length bytes of the object starting at offset
+func (s3manager *S3Manager) GetBucketObjectRange(objectName string, bucketName string, offset int64, length int64) (*BucketObjectReader, error) {
+	getInputs := &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	}
+	result, err := s3manager.S3Client.GetObject(getInputs)
 	if err != nil {
-		logging.ERROR("Recived error while reading body:", err)
-		return nil, err
+		logging.ERROR("Error, can't get fetch object range..")
+		return nil, translateS3Error(err)
 	}
-	return response, nil
+	return &BucketObjectReader{
+		ReadCloser: result.Body,
+		ObjectInfo: ObjectInfo{
+			Name:         objectName,
+			Size:         aws.Int64Value(result.ContentLength),
+			LastModified: aws.TimeValue(result.LastModified),
+		},
+	}, nil
 }
 
-func (s3manager *S3Manager) DeleteBucket(client *s3.S3, objectName string, bucketName string) {
-	success := s3manager.DeleteBucketObject(client, objectName, bucketName)
+// Returns the size and modification time of the object without fetching its content
+func (s3manager *S3Manager) HeadBucketObject(objectName string, bucketName string) (ObjectInfo, error) {
+	result, err := s3manager.S3Client.HeadObject(&s3.HeadObjectInput{
+		Bucket:       aws.String(bucketName),
+		Key:          aws.String(objectName),
+		ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+	})
+	if err != nil {
+		logging.ERROR("Error, can't get head of object..")
+		return ObjectInfo{}, translateS3Error(err)
+	}
+	info := ObjectInfo{
+		Name:         objectName,
+		Size:         aws.Int64Value(result.ContentLength),
+		LastModified: aws.TimeValue(result.LastModified),
+	}
+	// only known when the object was uploaded along with its SHA-256, as PresignUpload requires when
+	// given a checksum. The checksum of a multipart upload isn't the one of the content.
+	if sum, err := base64.StdEncoding.DecodeString(aws.StringValue(result.ChecksumSHA256)); err == nil && len(sum) == sha256.Size {
+		info.Checksum = hex.EncodeToString(sum)
+	}
+	return info, nil
+}
+
+func (s3manager *S3Manager) SupportsPresign() bool {
+	return true
+}
+
+// Returns a URL which allows to PUT the object without credentials until it expires.
+// When checksum (hex SHA-256) is given the client has to send it as x-amz-checksum-sha256
+// header (base64) and S3 rejects content which doesn't match it.
+func (s3manager *S3Manager) PresignUpload(objectName string, bucketName string, checksum string, expiry time.Duration) (string, error) {
+	doesBucketExist, err := s3manager.checkIfBucketExists(bucketName)
+	if err != nil {
+		return "", fmt.Errorf("unable to check bucket %s existence, Error : %v", bucketName, err)
+	}
+	if !doesBucketExist {
+		logging.INFO("Bucket " + bucketName + " Doesn't Exist, Creating One")
+		if err := s3manager.CreateBucket(bucketName); err != nil {
+			return "", fmt.Errorf("unable to create bucket for uploading-model, Error : %v", err)
+		}
+	}
+
+	putInputs := &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+	}
+	if checksum != "" {
+		sum, err := hex.DecodeString(checksum)
+		if err != nil {
+			return "", fmt.Errorf("invalid checksum %s: %v", checksum, err)
+		}
+		putInputs.ChecksumSHA256 = aws.String(base64.StdEncoding.EncodeToString(sum))
+	}
+	request, _ := s3manager.S3Client.PutObjectRequest(putInputs)
+	url, err := request.Presign(expiry)
+	if err != nil {
+		logging.ERROR("Error, can't presign upload of object..", "error", err)
+		return "", err
+	}
+	return url, nil
+}
+
+// Returns a URL which allows to GET the object without credentials until it expires
+func (s3manager *S3Manager) PresignDownload(objectName string, bucketName string, expiry time.Duration) (string, error) {
+	request, _ := s3manager.S3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectName),
+	})
+	url, err := request.Presign(expiry)
+	if err != nil {
+		logging.ERROR("Error, can't presign download of object..", "error", err)
+		return "", err
+	}
+	return url, nil
+}
+
+// Wraps the errors of missing objects or buckets into ErrObjectNotFound
+func translateS3Error(err error) error {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, s3.ErrCodeNoSuchBucket, "NotFound":
+			return fmt.Errorf("%w: %s", ErrObjectNotFound, aerr.Message())
+		}
+	}
+	return err
+}
+
+func (s3manager *S3Manager) DeleteBucket(objectName string, bucketName string) {
+	success := s3manager.DeleteBucketObject(objectName, bucketName)
 	if success {
 		deleteBucketInput := &s3.DeleteBucketInput{
 			Bucket: aws.String(bucketName),
 		}
-		client.DeleteBucket(deleteBucketInput)
+		s3manager.S3Client.DeleteBucket(deleteBucketInput)
 		logging.INFO("Bucket deleted successfully..")
 	} else {
 		logging.ERROR("Failed to delete the Bucket ...")
@@ -151,12 +290,12 @@ func (s3manager *S3Manager) DeleteBucket(client *s3.S3, objectName string, bucke
 
 }
 
-func (s3manager *S3Manager) DeleteBucketObject(client *s3.S3, objectName string, bucketName string) bool {
+func (s3manager *S3Manager) DeleteBucketObject(objectName string, bucketName string) bool {
 	deleteInput := &s3.DeleteObjectInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectName),
 	}
-	_, err := client.DeleteObject(deleteInput)
+	_, err := s3manager.S3Client.DeleteObject(deleteInput)
 	if err != nil {
 		logging.WARN("Can not delete the bucket object")
 		return false
@@ -165,6 +304,81 @@ func (s3manager *S3Manager) DeleteBucketObject(client *s3.S3, objectName string,
 	return true
 }
 
+// S3 has no rename, the object is copied server side to its new name and the source is
+// deleted afterwards. The copy is atomic, a failure to delete the source only leaves it behind.
+func (s3manager *S3Manager) MoveBucketObject(srcObjectName string, dstObjectName string, bucketName string) error {
+	source, err := s3manager.HeadBucketObject(srcObjectName, bucketName)
+	if err != nil {
+		return err
+	}
+
+	copySource := url.PathEscape(bucketName + "/" + srcObjectName)
+	if source.Size <= maxCopyObjectSize {
+		_, err = s3manager.S3Client.CopyObject(&s3.CopyObjectInput{
+			Bucket:     aws.String(bucketName),
+			Key:        aws.String(dstObjectName),
+			CopySource: aws.String(copySource),
+		})
+	} else {
+		err = s3manager.copyLargeObject(copySource, source.Size, dstObjectName, bucketName)
+	}
+	if err != nil {
+		logging.ERROR("Can not copy "+srcObjectName+" to "+dstObjectName, "error", err)
+		return translateS3Error(err)
+	}
+
+	if !s3manager.DeleteBucketObject(srcObjectName, bucketName) {
+		logging.WARN("Object " + srcObjectName + " was copied but not deleted")
+	}
+	return nil
+}
+
+func (s3manager *S3Manager) copyLargeObject(copySource string, size int64, dstObjectName string, bucketName string) error {
+	upload, err := s3manager.S3Client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(dstObjectName),
+	})
+	if err != nil {
+		return err
+	}
+
+	parts := []*s3.CompletedPart{}
+	for offset, partNumber := int64(0), int64(1); offset < size; offset, partNumber = offset+copyPartSize, partNumber+1 {
+		last := offset + copyPartSize - 1
+		if last >= size {
+			last = size - 1
+		}
+		result, err := s3manager.S3Client.UploadPartCopy(&s3.UploadPartCopyInput{
+			Bucket:          aws.String(bucketName),
+			Key:             aws.String(dstObjectName),
+			UploadId:        upload.UploadId,
+			PartNumber:      aws.Int64(partNumber),
+			CopySource:      aws.String(copySource),
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", offset, last)),
+		})
+		if err != nil {
+			s3manager.S3Client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucketName),
+				Key:      aws.String(dstObjectName),
+				UploadId: upload.UploadId,
+			})
+			return err
+		}
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       result.CopyPartResult.ETag,
+			PartNumber: aws.Int64(partNumber),
+		})
+	}
+
+	_, err = s3manager.S3Client.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String(dstObjectName),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	return err
+}
+
 func (s3manager *S3Manager) checkIfBucketExists(bucketName string) (bool, error) {
 	_, err := s3manager.S3Client.HeadBucket(&s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
@@ -184,7 +398,7 @@ func (s3manager *S3Manager) checkIfBucketExists(bucketName string) (bool, error)
 	return true, nil
 }
 
-func (s3manager *S3Manager) UploadFile(dataBytes []byte, file_name string, bucketName string) error {
+func (s3manager *S3Manager) UploadFile(data io.Reader, file_name string, bucketName string) error {
 
 	doesBucketExist, err := s3manager.checkIfBucketExists(bucketName)
 	if err != nil {
@@ -199,14 +413,17 @@ func (s3manager *S3Manager) UploadFile(dataBytes []byte, file_name string, bucke
 		}
 	}
 
-	dataReader := bytes.NewReader(dataBytes) //bytes.Reader is type of io.ReadSeeker
-
-	params := &s3.PutObjectInput{
+	// The uploader switches to a multipart upload when data is larger than
+	// one part, so the artifact never has to be held in memory as a whole
+	uploader := s3upload.NewUploaderWithClient(s3manager.S3Client, func(u *s3upload.Uploader) {
+		u.PartSize = uploadPartSize
+	})
+	params := &s3upload.UploadInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(file_name),
-		Body:   dataReader,
+		Body:   data,
 	}
-	_, err = s3manager.S3Client.PutObject(params)
+	_, err = uploader.Upload(params)
 	if err != nil {
 		logging.ERROR("Error in uploading file to bucket ", err)
 		return err
@@ -230,7 +447,7 @@ func (s3manager *S3Manager) ListBucket(bucketObjPostfix string) ([]Bucket, error
 			continue
 		}
 
-		bucketObject, err := s3manager.GetBucketObject(*bucket.Name+bucketObjPostfix, *bucket.Name)
+		bucketObject, err := readBucketObject(s3manager, *bucket.Name+bucketObjPostfix, *bucket.Name)
 		if err != nil {
 			logging.ERROR("Unable to list bucketname ", *bucket.Name, ": Error : ", err.Error())
 			continue
@@ -248,6 +465,60 @@ func (s3manager *S3Manager) ListBucket(bucketObjPostfix string) ([]Bucket, error
 	return BucketList, nil
 }
 
-// Return list of objects in the buckets
-func (S3Manager *S3Manager) GetBucketItems(bucketName string) {
+// Return the names of all the buckets of the storage
+func (s3manager *S3Manager) ListBucketNames() ([]string, error) {
+	listBucketsOutput, err := s3manager.S3Client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		logging.ERROR("Can't get bucket list in s3 ", err)
+		return nil, err
+	}
+
+	names := []string{}
+	for _, bucket := range listBucketsOutput.Buckets {
+		if bucket.Name != nil {
+			names = append(names, *bucket.Name)
+		}
+	}
+	return names, nil
+}
+
+// Reads a whole object into memory, only meant for small objects such as
+// model info files
+func readBucketObject(dbMgr DBMgr, objectName string, bucketName string) (BucketObject, error) {
+	reader, err := dbMgr.GetBucketObject(objectName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	response, err := io.ReadAll(reader)
+	if err != nil {
+		logging.ERROR("Recived error while reading body:", err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// Return list of objects in the bucket, a missing bucket has no objects
+func (s3manager *S3Manager) GetBucketItems(bucketName string) ([]ObjectInfo, error) {
+	items := []ObjectInfo{}
+	err := s3manager.S3Client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			items = append(items, ObjectInfo{
+				Name:         aws.StringValue(object.Key),
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		if err = translateS3Error(err); errors.Is(err, ErrObjectNotFound) {
+			return []ObjectInfo{}, nil
+		}
+		logging.ERROR("Can't list objects of bucket "+bucketName, "error", err)
+		return nil, err
+	}
+	return items, nil
 }
@@ -0,0 +1,150 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"sort"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The latest alias isn't stored, it follows the current artifact version of the registration
+func latestAlias(m *models.ModelRelatedInformation) (models.ArtifactAlias, bool) {
+	if m.ModelId.ArtifactVersion == "" || m.ModelId.ArtifactVersion == "0.0.0" {
+		return models.ArtifactAlias{}, false
+	}
+	return models.ArtifactAlias{
+		ModelRelatedInformationID: m.Id,
+		Name:                      models.AliasLatest,
+		ArtifactVersion:           m.ModelId.ArtifactVersion,
+	}, true
+}
+
+// ListArtifactAliases returns the aliases of the registration ordered by name, latest included
+func (repo *ModelInfoRepository) ListArtifactAliases(modelRegistrationId string) ([]models.ArtifactAlias, error) {
+	var m models.ModelRelatedInformation
+	if err := repo.db.Session(&gorm.Session{SkipHooks: true}).
+		Where("id = ?", modelRegistrationId).
+		First(&m).Error; err != nil {
+		return nil, err
+	}
+	aliases := []models.ArtifactAlias{}
+	if err := repo.db.Where("model_related_information_id = ?", modelRegistrationId).
+		Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+	if latest, ok := latestAlias(&m); ok {
+		// latest moved when its artifact version was committed
+		var artifact models.ArtifactVersion
+		if err := repo.db.Where("model_related_information_id = ? AND version = ?", m.Id, latest.ArtifactVersion).
+			Limit(1).Find(&artifact).Error; err != nil {
+			return nil, err
+		}
+		latest.UpdatedAt = artifact.UploadedAt
+		aliases = append(aliases, latest)
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	return aliases, nil
+}
+
+// SetArtifactAlias points the alias to a committed artifact version, creating the alias or moving it.
+// ErrNotFound is returned when the artifact version doesn't exist.
+func (repo *ModelInfoRepository) SetArtifactAlias(modelRegistrationId string, alias string, artifactVersion string) (*models.ArtifactAlias, error) {
+	artifactAlias := models.ArtifactAlias{
+		ModelRelatedInformationID: modelRegistrationId,
+		Name:                      alias,
+		ArtifactVersion:           artifactVersion,
+		UpdatedAt:                 time.Now(),
+	}
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		var artifact models.ArtifactVersion
+		if err := tx.Where("model_related_information_id = ? AND version = ?", modelRegistrationId, artifactVersion).
+			First(&artifact).Error; err != nil {
+			return err
+		}
+		if artifact.Status == models.ArtifactStatusRevoked {
+			return ErrArtifactVersionRevoked
+		}
+		var before interface{}
+		var previous models.ArtifactAlias
+		if err := tx.Where("model_related_information_id = ? AND name = ?", modelRegistrationId, alias).
+			Limit(1).Find(&previous).Error; err != nil {
+			return err
+		}
+		if previous.Name != "" {
+			before = previous
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "model_related_information_id"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"artifact_version", "updated_at"}),
+		}).Create(&artifactAlias).Error; err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, modelRegistrationId, models.AuditAliasSet, before, artifactAlias)
+	})
+	if err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	return &artifactAlias, nil
+}
+
+func (repo *ModelInfoRepository) DeleteArtifactAlias(modelRegistrationId string, alias string) (int64, error) {
+	var rows int64
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		var artifactAlias models.ArtifactAlias
+		if err := tx.Where("model_related_information_id = ? AND name = ?", modelRegistrationId, alias).
+			Limit(1).Find(&artifactAlias).Error; err != nil || artifactAlias.Name == "" {
+			return err
+		}
+		res := tx.Where("model_related_information_id = ? AND name = ?", modelRegistrationId, alias).
+			Delete(&models.ArtifactAlias{})
+		if res.Error != nil {
+			return res.Error
+		}
+		rows = res.RowsAffected
+		return repo.recordAudit(tx, modelRegistrationId, models.AuditAliasDeleted, artifactAlias, nil)
+	})
+	return rows, translateError(repo.db, err)
+}
+
+// ResolveArtifactAlias returns the artifact version the alias of the model version points to,
+// ErrNotFound is returned for an unknown alias
+func (repo *ModelInfoRepository) ResolveArtifactAlias(modelName string, modelVersion string, alias string) (string, error) {
+	var m models.ModelRelatedInformation
+	if err := repo.db.Session(&gorm.Session{SkipHooks: true}).
+		Where("model_name = ? AND model_version = ?", modelName, modelVersion).
+		First(&m).Error; err != nil {
+		return "", translateError(repo.db, err)
+	}
+	if alias == models.AliasLatest {
+		latest, ok := latestAlias(&m)
+		if !ok {
+			return "", ErrNotFound
+		}
+		return latest.ArtifactVersion, nil
+	}
+	var artifactAlias models.ArtifactAlias
+	if err := repo.db.Where("model_related_information_id = ? AND name = ?", m.Id, alias).
+		First(&artifactAlias).Error; err != nil {
+		return "", translateError(repo.db, err)
+	}
+	return artifactAlias.ArtifactVersion, nil
+}
@@ -0,0 +1,70 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"sort"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"gorm.io/gorm"
+)
+
+// ListArtifactVersions returns the artifact versions committed for the registration, oldest first
+func (repo *ModelInfoRepository) ListArtifactVersions(modelRegistrationId string) ([]models.ArtifactVersion, error) {
+	var artifacts []models.ArtifactVersion
+	if err := repo.db.Where("model_related_information_id = ?", modelRegistrationId).
+		Find(&artifacts).Error; err != nil {
+		return nil, err
+	}
+	// versions are semver strings, so they are ordered here rather than by the database
+	sort.Slice(artifacts, func(i, j int) bool {
+		return utils.CompareArtifactVersions(artifacts[i].Version, artifacts[j].Version) < 0
+	})
+	return artifacts, nil
+}
+
+func (repo *ModelInfoRepository) GetArtifactVersion(modelRegistrationId string, artifactVersion string) (*models.ArtifactVersion, error) {
+	var artifact models.ArtifactVersion
+	if err := repo.db.Where("model_related_information_id = ? AND version = ?", modelRegistrationId, artifactVersion).
+		First(&artifact).Error; err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	return &artifact, nil
+}
+
+// SetArtifactVersionStatus marks an artifact version, ErrNotFound is returned when it doesn't exist
+func (repo *ModelInfoRepository) SetArtifactVersionStatus(modelRegistrationId string, artifactVersion string, status string) (*models.ArtifactVersion, error) {
+	var artifact models.ArtifactVersion
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("model_related_information_id = ? AND version = ?", modelRegistrationId, artifactVersion).
+			First(&artifact).Error; err != nil {
+			return err
+		}
+		before := artifact
+		artifact.Status = status
+		if err := tx.Model(&artifact).Update("status", status).Error; err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, modelRegistrationId, models.AuditArtifactStatus, before, artifact)
+	})
+	if err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	return &artifact, nil
+}
@@ -0,0 +1,83 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"encoding/json"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
+	"gorm.io/gorm"
+)
+
+// WithAudit returns the repository recording its changes in the audit trail on behalf of the given actor
+func (repo *ModelInfoRepository) WithAudit(audit models.AuditContext) IDB {
+	return &ModelInfoRepository{db: repo.db, audit: audit}
+}
+
+// Snapshot audited for an artifact commit, the registration changes along with the artifact when
+// it becomes the current one. The row version, not part of the registration JSON, is its ETag.
+type artifactCommitSnapshot struct {
+	Registration *models.ModelRelatedInformation `json:"registration"`
+	RowVersion   int64                           `json:"rowVersion"`
+	Artifact     *models.ArtifactVersion         `json:"artifact,omitempty"`
+}
+
+func auditSnapshot(entity interface{}) ([]byte, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	return json.Marshal(entity)
+}
+
+// Records the change in the audit trail, within the transaction making it. before and after
+// are nil when the entity is created or removed.
+func (repo *ModelInfoRepository) recordAudit(tx *gorm.DB, modelRegistrationId string, action string, before interface{}, after interface{}) error {
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+	diff, err := utils.CreateMergePatch(beforeJSON, afterJSON)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.AuditEvent{
+		ModelRelatedInformationID: modelRegistrationId,
+		Action:                    action,
+		Actor:                     repo.audit.Actor,
+		RequestId:                 repo.audit.RequestId,
+		Before:                    models.JSONText(beforeJSON),
+		After:                     models.JSONText(afterJSON),
+		Diff:                      models.JSONText(diff),
+	}).Error
+}
+
+// ListAuditEvents returns the audit trail of the registration, oldest first
+func (repo *ModelInfoRepository) ListAuditEvents(modelRegistrationId string) ([]models.AuditEvent, error) {
+	events := []models.AuditEvent{}
+	if err := repo.db.Where("model_related_information_id = ?", modelRegistrationId).
+		Order("created_at").Order("id").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
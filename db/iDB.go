@@ -18,16 +18,59 @@ limitations under the License.
 package db
 
 import (
+	"errors"
+	"time"
+
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
 )
 
+// ErrArtifactVersionConflict is returned when committing an artifact version which was
+// not allocated or is already the current one
+var ErrArtifactVersionConflict = errors.New("artifact version can't be committed")
+
+// ErrArtifactVersionRevoked is returned when pointing an alias to a revoked artifact version
+var ErrArtifactVersionRevoked = errors.New("artifact version is revoked")
+
+// ErrNotFound is returned when the registration, or the record of the registration, doesn't exist
+var ErrNotFound = errors.New("record not found")
+
+// ErrStale is returned when the registration isn't at the row version the change was based on anymore
+var ErrStale = errors.New("registration was modified concurrently")
+
+// ErrConflict is returned when a record with the same key already exists, such as a registration
+// of the same model name and version
+var ErrConflict = errors.New("record already exists")
+
+// ErrLifecycleTransition is returned when the lifecycle graph doesn't allow the requested transition
+var ErrLifecycleTransition = errors.New("lifecycle transition not allowed")
+
 type IDB interface {
 	Create(modelInfo models.ModelRelatedInformation) error
 	GetByID(id string) (*models.ModelRelatedInformation, error)
 	GetAll() ([]models.ModelRelatedInformation, error)
+	Find(query models.ModelInfoQuery) ([]models.ModelRelatedInformation, int64, error)
+	Search(query models.SearchQuery) ([]models.SearchResult, int64, error)
 	GetModelInfoByName(modelName string) ([]models.ModelRelatedInformation, error)
 	GetModelInfoByNameAndVer(modelName string, modelVersion string) (*models.ModelRelatedInformation, error)
 	GetModelInfoById(id string) (*models.ModelRelatedInformation, error)
 	Update(modelInfo models.ModelRelatedInformation) error
-	Delete(id string) (int64, error)
+	SetCurrentArtifactVersion(modelName string, modelVersion string, artifactVersion string, checksum string, rowVersion int64) error
+	Delete(id string, deletedBy string, rowVersion int64) (int64, error)
+	Restore(id string) (*models.ModelRelatedInformation, error)
+	ListDeleted(deletedBefore time.Time) ([]models.ModelRelatedInformation, error)
+	Purge(id string) (int64, error)
+	AllocateArtifactVersion(modelName string, modelVersion string, requested string) (string, error)
+	CommitArtifactVersion(modelName string, modelVersion string, artifact models.ArtifactVersion, promote func() error) (*models.ModelRelatedInformation, error)
+	ListArtifactVersions(modelRegistrationId string) ([]models.ArtifactVersion, error)
+	GetArtifactVersion(modelRegistrationId string, artifactVersion string) (*models.ArtifactVersion, error)
+	SetArtifactVersionStatus(modelRegistrationId string, artifactVersion string, status string) (*models.ArtifactVersion, error)
+	ListArtifactAliases(modelRegistrationId string) ([]models.ArtifactAlias, error)
+	SetArtifactAlias(modelRegistrationId string, alias string, artifactVersion string) (*models.ArtifactAlias, error)
+	DeleteArtifactAlias(modelRegistrationId string, alias string) (int64, error)
+	ResolveArtifactAlias(modelName string, modelVersion string, alias string) (string, error)
+	TransitionLifecycleState(modelRegistrationId string, state string, actor string, reason string) (*models.LifecycleTransition, error)
+	ListLifecycleTransitions(modelRegistrationId string) ([]models.LifecycleTransition, error)
+	ListAuditEvents(modelRegistrationId string) ([]models.AuditEvent, error)
+	// WithAudit returns the IDB recording the changes it makes in the audit trail on behalf of the actor
+	WithAudit(audit models.AuditContext) IDB
 }
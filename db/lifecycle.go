@@ -0,0 +1,84 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"fmt"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gorm.io/gorm"
+)
+
+// TransitionLifecycleState moves the registration to the given lifecycle state and records who moved it
+// and why. ErrLifecycleTransition is returned when the transition graph doesn't allow it.
+func (repo *ModelInfoRepository) TransitionLifecycleState(modelRegistrationId string, state string, actor string, reason string) (*models.LifecycleTransition, error) {
+	var transition models.LifecycleTransition
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		var m models.ModelRelatedInformation
+		if err := lockForUpdate(tx).Session(&gorm.Session{SkipHooks: true}).
+			Where("id = ?", modelRegistrationId).
+			First(&m).Error; err != nil {
+			return err
+		}
+		if !models.LifecycleTransitionAllowed(m.LifecycleState, state) {
+			return fmt.Errorf("%w: %s to %s", ErrLifecycleTransition, m.LifecycleState, state)
+		}
+		before, err := registrationSnapshot(tx, "id = ?", modelRegistrationId)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ModelRelatedInformation{}).
+			Where("id = ?", modelRegistrationId).
+			Updates(map[string]interface{}{
+				"lifecycle_state": state,
+				"row_version":     gorm.Expr("row_version + 1"),
+			}).Error; err != nil {
+			return err
+		}
+		transition = models.LifecycleTransition{
+			ModelRelatedInformationID: modelRegistrationId,
+			FromState:                 m.LifecycleState,
+			ToState:                   state,
+			Actor:                     actor,
+			Reason:                    reason,
+		}
+		if err := tx.Create(&transition).Error; err != nil {
+			return err
+		}
+		after, err := registrationSnapshot(tx, "id = ?", modelRegistrationId)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, modelRegistrationId, models.AuditLifecycleTransition, before, after)
+	})
+	if err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	return &transition, nil
+}
+
+// ListLifecycleTransitions returns the lifecycle transitions of the registration, oldest first
+func (repo *ModelInfoRepository) ListLifecycleTransitions(modelRegistrationId string) ([]models.LifecycleTransition, error) {
+	transitions := []models.LifecycleTransition{}
+	if err := repo.db.Where("model_related_information_id = ?", modelRegistrationId).
+		Order("created_at").
+		Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
@@ -0,0 +1,285 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"gorm.io/gorm"
+)
+
+/*
+The schema is changed by numbered migrations, the scripts of each dialect are in
+migrations/<dialect>/<version>_<name>.up.sql with the matching .down.sql reverting it. A
+version is never changed once released, a new one is added instead. The applied versions
+are recorded in the schema_migrations table.
+*/
+//go:embed migrations
+var migrationScripts embed.FS
+
+var migrationScriptName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrSchemaTooNew is returned when the database has migrations applied this binary doesn't know
+var ErrSchemaTooNew = errors.New("database schema is newer than this version of the service")
+
+// Serializes the replicas migrating the same Postgres database
+const migrationLockId = 7468723
+
+// Migration is a numbered change of the schema
+type Migration struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	up      string
+	down    string
+}
+
+// MigrationStatus tells whether a migration is applied, the migrations applied by a newer binary are
+// reported as well, without Known
+type MigrationStatus struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Known     bool       `json:"known"`
+	AppliedAt *time.Time `json:"appliedAt"`
+}
+
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string { return "schema_migrations" }
+
+// Migrations returns the migrations of the dialect, in order
+func Migrations(dialect string) ([]Migration, error) {
+	dir := path.Join("migrations", dialect)
+	entries, err := fs.ReadDir(migrationScripts, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s: %w", dialect, err)
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := migrationScriptName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration script %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		script, err := fs.ReadFile(migrationScripts, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		migration, found := byVersion[version]
+		if !found {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names, %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.up = string(script)
+		} else {
+			migration.down = string(script)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, fmt.Errorf("migration %d is missing", i+1)
+		}
+	}
+	return migrations, nil
+}
+
+func createSchemaMigrations(db *gorm.DB) error {
+	return db.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (" +
+		"version integer PRIMARY KEY, name text NOT NULL, applied_at timestamp NOT NULL)").Error
+}
+
+func appliedMigrations(db *gorm.DB) ([]schemaMigration, error) {
+	var applied []schemaMigration
+	err := db.Order("version").Find(&applied).Error
+	return applied, err
+}
+
+// Runs the change of the schema in a transaction, along with its record in schema_migrations.
+// skip tells from the applied migrations, read within the transaction, whether the change is
+// already made, by another replica.
+func migrateStep(db *gorm.DB, skip func(applied map[int]bool) bool, change func(tx *gorm.DB) error) (bool, error) {
+	done := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockId).Error; err != nil {
+				return err
+			}
+		}
+		rows, err := appliedMigrations(tx)
+		if err != nil {
+			return err
+		}
+		applied := map[int]bool{}
+		for _, row := range rows {
+			applied[row.Version] = true
+		}
+		if skip(applied) {
+			return nil
+		}
+		done = true
+		return change(tx)
+	})
+	return done, err
+}
+
+// MigrateUp applies the migrations up to the target version, every known migration when target is 0
+func MigrateUp(db *gorm.DB, target int) ([]Migration, error) {
+	migrations, err := Migrations(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckSchemaVersion(db); err != nil {
+		return nil, err
+	}
+	done := []Migration{}
+	for _, migration := range migrations {
+		if target > 0 && migration.Version > target {
+			break
+		}
+		migration := migration
+		applied, err := migrateStep(db, func(applied map[int]bool) bool {
+			return applied[migration.Version]
+		}, func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		if applied {
+			logging.INFO("Migration applied", "version", migration.Version, "name", migration.Name)
+			done = append(done, migration)
+		}
+	}
+	return done, nil
+}
+
+// MigrateDown reverts the given number of migrations, the latest first
+func MigrateDown(db *gorm.DB, steps int) ([]Migration, error) {
+	migrations, err := Migrations(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckSchemaVersion(db); err != nil {
+		return nil, err
+	}
+	done := []Migration{}
+	for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := migrations[i]
+		reverted, err := migrateStep(db, func(applied map[int]bool) bool {
+			return !applied[migration.Version]
+		}, func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		if reverted {
+			logging.INFO("Migration reverted", "version", migration.Version, "name", migration.Name)
+			done = append(done, migration)
+		}
+	}
+	return done, nil
+}
+
+// GetMigrationStatus lists the known migrations followed by the unknown ones applied to the database
+func GetMigrationStatus(db *gorm.DB) ([]MigrationStatus, error) {
+	migrations, err := Migrations(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	if err := createSchemaMigrations(db); err != nil {
+		return nil, err
+	}
+	rows, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int]schemaMigration{}
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	status := []MigrationStatus{}
+	for _, migration := range migrations {
+		state := MigrationStatus{Version: migration.Version, Name: migration.Name, Known: true}
+		if row, found := applied[migration.Version]; found {
+			state.AppliedAt = &row.AppliedAt
+			delete(applied, migration.Version)
+		}
+		status = append(status, state)
+	}
+	for _, row := range rows {
+		if _, unknown := applied[row.Version]; unknown {
+			row := row
+			status = append(status, MigrationStatus{Version: row.Version, Name: row.Name, AppliedAt: &row.AppliedAt})
+		}
+	}
+	return status, nil
+}
+
+// CheckSchemaVersion returns ErrSchemaTooNew when the database was migrated by a newer binary, the
+// service must not run against a schema it doesn't know
+func CheckSchemaVersion(db *gorm.DB) error {
+	status, err := GetMigrationStatus(db)
+	if err != nil {
+		return err
+	}
+	for _, state := range status {
+		if !state.Known {
+			return fmt.Errorf("%w: migration %d_%s is applied", ErrSchemaTooNew, state.Version, state.Name)
+		}
+	}
+	return nil
+}
+
+// Migrate brings the schema to the version of the binary, ErrSchemaTooNew is returned when it is newer
+func Migrate(db *gorm.DB) error {
+	_, err := MigrateUp(db, 0)
+	return err
+}
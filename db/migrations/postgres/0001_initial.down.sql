@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS target_environments;
+DROP TABLE IF EXISTS model_related_informations;
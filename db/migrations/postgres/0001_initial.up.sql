@@ -0,0 +1,25 @@
+-- Registrations as created by the releases before the versioned migrations, the
+-- IF NOT EXISTS clauses let it be recorded on their databases as it is.
+CREATE TABLE IF NOT EXISTS model_related_informations (
+    id text CONSTRAINT uni_model_related_informations_id UNIQUE,
+    model_name text,
+    model_version text,
+    artifact_version text,
+    description text,
+    author text,
+    owner text,
+    input_data_type text,
+    output_data_type text,
+    model_location text,
+    PRIMARY KEY (model_name, model_version)
+);
+
+CREATE TABLE IF NOT EXISTS target_environments (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    platform_name text,
+    environment_type text,
+    dependency_list text
+);
+CREATE INDEX IF NOT EXISTS idx_target_environments_model_related_information_id
+    ON target_environments (model_related_information_id);
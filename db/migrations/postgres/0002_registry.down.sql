@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS audit_events;
+DROP TABLE IF EXISTS lifecycle_transitions;
+DROP TABLE IF EXISTS artifact_aliases;
+DROP TABLE IF EXISTS artifact_versions;
+
+DROP INDEX IF EXISTS idx_model_related_informations_search;
+ALTER TABLE model_related_informations
+    DROP COLUMN IF EXISTS row_version,
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS lifecycle_state,
+    DROP COLUMN IF EXISTS allocated_artifact_version,
+    DROP COLUMN IF EXISTS artifact_checksum;
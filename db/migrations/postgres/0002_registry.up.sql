@@ -0,0 +1,76 @@
+-- Artifact history and aliases, lifecycle, soft delete, audit trail, optimistic
+-- concurrency and full-text search of the registrations.
+ALTER TABLE model_related_informations
+    ADD COLUMN IF NOT EXISTS artifact_checksum text,
+    ADD COLUMN IF NOT EXISTS allocated_artifact_version text,
+    ADD COLUMN IF NOT EXISTS lifecycle_state text NOT NULL DEFAULT 'registered',
+    ADD COLUMN IF NOT EXISTS created_at timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_by text,
+    ADD COLUMN IF NOT EXISTS row_version bigint NOT NULL DEFAULT 1;
+-- the next artifact versions are allocated after the current one
+UPDATE model_related_informations SET allocated_artifact_version = artifact_version
+    WHERE allocated_artifact_version IS NULL;
+CREATE INDEX IF NOT EXISTS idx_model_related_informations_lifecycle_state
+    ON model_related_informations (lifecycle_state);
+CREATE INDEX IF NOT EXISTS idx_model_related_informations_created_at
+    ON model_related_informations (created_at);
+CREATE INDEX IF NOT EXISTS idx_model_related_informations_deleted_at
+    ON model_related_informations (deleted_at);
+-- must be the searchDocument expression of db/search.go for the searches to use it
+CREATE INDEX IF NOT EXISTS idx_model_related_informations_search
+    ON model_related_informations USING GIN (to_tsvector('english', coalesce(description, '') || ' ' || coalesce(model_name, '') || ' ' || coalesce(author, '') || ' ' || coalesce(owner, '') || ' ' || replace(coalesce(input_data_type, ''), ',', ' ') || ' ' || replace(coalesce(output_data_type, ''), ',', ' ')));
+
+CREATE TABLE IF NOT EXISTS artifact_versions (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    version text NOT NULL,
+    object_name text,
+    size bigint,
+    digest text,
+    status text NOT NULL,
+    uploaded_by text,
+    uploaded_at timestamptz,
+    updated_at timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_artifact_versions_registration_version
+    ON artifact_versions (model_related_information_id, version);
+
+CREATE TABLE IF NOT EXISTS artifact_aliases (
+    model_related_information_id text,
+    name text,
+    artifact_version text NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (model_related_information_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS lifecycle_transitions (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    from_state text NOT NULL,
+    to_state text NOT NULL,
+    actor text,
+    reason text,
+    created_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_lifecycle_transitions_model_related_information_id
+    ON lifecycle_transitions (model_related_information_id);
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    action text NOT NULL,
+    actor text,
+    request_id text,
+    "before" text,
+    "after" text,
+    diff text,
+    created_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_model_related_information_id
+    ON audit_events (model_related_information_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at
+    ON audit_events (created_at);
+-- the audit trail is append-only
+CREATE OR REPLACE RULE audit_events_no_update AS ON UPDATE TO audit_events DO INSTEAD NOTHING;
+CREATE OR REPLACE RULE audit_events_no_delete AS ON DELETE TO audit_events DO INSTEAD NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE target_environments;
+DROP TABLE model_related_informations;
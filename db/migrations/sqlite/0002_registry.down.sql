@@ -0,0 +1,15 @@
+DROP TABLE audit_events;
+DROP TABLE lifecycle_transitions;
+DROP TABLE artifact_aliases;
+DROP TABLE artifact_versions;
+
+DROP INDEX idx_model_related_informations_deleted_at;
+DROP INDEX idx_model_related_informations_created_at;
+DROP INDEX idx_model_related_informations_lifecycle_state;
+ALTER TABLE model_related_informations DROP COLUMN row_version;
+ALTER TABLE model_related_informations DROP COLUMN deleted_by;
+ALTER TABLE model_related_informations DROP COLUMN deleted_at;
+ALTER TABLE model_related_informations DROP COLUMN created_at;
+ALTER TABLE model_related_informations DROP COLUMN lifecycle_state;
+ALTER TABLE model_related_informations DROP COLUMN allocated_artifact_version;
+ALTER TABLE model_related_informations DROP COLUMN artifact_checksum;
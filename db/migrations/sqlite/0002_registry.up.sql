@@ -0,0 +1,69 @@
+-- Artifact history and aliases, lifecycle, soft delete, audit trail and optimistic
+-- concurrency of the registrations. SQLite is searched without index.
+ALTER TABLE model_related_informations ADD COLUMN artifact_checksum text;
+ALTER TABLE model_related_informations ADD COLUMN allocated_artifact_version text;
+ALTER TABLE model_related_informations ADD COLUMN lifecycle_state text NOT NULL DEFAULT 'registered';
+ALTER TABLE model_related_informations ADD COLUMN created_at datetime;
+ALTER TABLE model_related_informations ADD COLUMN deleted_at datetime;
+ALTER TABLE model_related_informations ADD COLUMN deleted_by text;
+ALTER TABLE model_related_informations ADD COLUMN row_version integer NOT NULL DEFAULT 1;
+-- the next artifact versions are allocated after the current one
+UPDATE model_related_informations SET allocated_artifact_version = artifact_version
+    WHERE allocated_artifact_version IS NULL;
+CREATE INDEX idx_model_related_informations_lifecycle_state
+    ON model_related_informations (lifecycle_state);
+CREATE INDEX idx_model_related_informations_created_at
+    ON model_related_informations (created_at);
+CREATE INDEX idx_model_related_informations_deleted_at
+    ON model_related_informations (deleted_at);
+
+CREATE TABLE artifact_versions (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    version text NOT NULL,
+    object_name text,
+    size integer,
+    digest text,
+    status text NOT NULL,
+    uploaded_by text,
+    uploaded_at datetime,
+    updated_at datetime
+);
+CREATE UNIQUE INDEX idx_artifact_versions_registration_version
+    ON artifact_versions (model_related_information_id, version);
+
+CREATE TABLE artifact_aliases (
+    model_related_information_id text,
+    name text,
+    artifact_version text NOT NULL,
+    updated_at datetime,
+    PRIMARY KEY (model_related_information_id, name)
+);
+
+CREATE TABLE lifecycle_transitions (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    from_state text NOT NULL,
+    to_state text NOT NULL,
+    actor text,
+    reason text,
+    created_at datetime
+);
+CREATE INDEX idx_lifecycle_transitions_model_related_information_id
+    ON lifecycle_transitions (model_related_information_id);
+
+CREATE TABLE audit_events (
+    id text PRIMARY KEY,
+    model_related_information_id text NOT NULL,
+    action text NOT NULL,
+    actor text,
+    request_id text,
+    "before" text,
+    "after" text,
+    diff text,
+    created_at datetime
+);
+CREATE INDEX idx_audit_events_model_related_information_id
+    ON audit_events (model_related_information_id);
+CREATE INDEX idx_audit_events_created_at
+    ON audit_events (created_at);
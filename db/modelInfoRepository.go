@@ -18,13 +18,20 @@ limitations under the License.
 package db
 
 import (
+	"errors"
+	"time"
+
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ModelInfoRepository struct {
 	db *gorm.DB
+	// who the changes are recorded in the audit trail for, see WithAudit
+	audit models.AuditContext
 }
 
 func NewModelInfoRepository(db *gorm.DB) *ModelInfoRepository {
@@ -55,22 +62,47 @@ func replaceTargetEnvs(tx *gorm.DB, m *models.ModelRelatedInformation) error {
 	return tx.Create(&rows).Error
 }
 
+// Loads the registration along with its target environments, deleted or not, as recorded in the audit trail
+func registrationSnapshot(tx *gorm.DB, query string, args ...interface{}) (*models.ModelRelatedInformation, error) {
+	var m models.ModelRelatedInformation
+	if err := tx.Session(&gorm.Session{SkipHooks: true}).Unscoped().
+		Where(query, args...).
+		First(&m).Error; err != nil {
+		return nil, err
+	}
+	if err := attachEnvsOne(tx, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Create registers the model, ErrConflict is returned when the model name and version are already registered
 func (repo *ModelInfoRepository) Create(m models.ModelRelatedInformation) error {
-	return repo.db.Transaction(func(tx *gorm.DB) error {
+	m.RowVersion = 1
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(&m).Error; err != nil {
 			return err
 		}
-		return replaceTargetEnvs(tx, &m)
+		if err := replaceTargetEnvs(tx, &m); err != nil {
+			return err
+		}
+		after, err := registrationSnapshot(tx, "id = ?", m.Id)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, m.Id, models.AuditRegistrationCreated, nil, after)
 	})
+	return translateError(repo.db, err)
 }
 
 func (repo *ModelInfoRepository) GetByID(id string) (*models.ModelRelatedInformation, error) {
 	return nil, nil
 }
 
+// GetAll returns every registration, including the deleted ones whose artifacts are kept until they are purged
 func (repo *ModelInfoRepository) GetAll() ([]models.ModelRelatedInformation, error) {
 	var modelInfos []models.ModelRelatedInformation
-	result := repo.db.Session(&gorm.Session{SkipHooks: true}).Find(&modelInfos)
+	result := repo.db.Session(&gorm.Session{SkipHooks: true}).Unscoped().Find(&modelInfos)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -80,28 +112,335 @@ func (repo *ModelInfoRepository) GetAll() ([]models.ModelRelatedInformation, err
 	return modelInfos, nil
 }
 
+// Update replaces the registration with the same model name and version, except for the fields
+// maintained by the service such as the current artifact. When m.RowVersion is set, the
+// registration is only replaced if it is still at that row version, ErrStale is returned otherwise.
 func (repo *ModelInfoRepository) Update(m models.ModelRelatedInformation) error {
-	return repo.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(&m).Error; err != nil {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		byKey := "model_name = ? AND model_version = ?"
+		before, err := registrationSnapshot(tx, byKey, m.ModelId.ModelName, m.ModelId.ModelVersion)
+		if err != nil {
+			return err
+		}
+		if before.DeletedAt.Valid {
+			return ErrNotFound
+		}
+		if m.RowVersion == 0 {
+			m.RowVersion = before.RowVersion
+		}
+		if m.RowVersion != before.RowVersion {
+			return ErrStale
+		}
+		expected := m.RowVersion
+		m.RowVersion++
+		// the allocation is only moved forward by AllocateArtifactVersion, the current artifact
+		// by CommitArtifactVersion and SetCurrentArtifactVersion and the lifecycle state by
+		// TransitionLifecycleState
+		res := tx.Model(&models.ModelRelatedInformation{}).
+			Where(byKey+" AND row_version = ?", m.ModelId.ModelName, m.ModelId.ModelVersion, expected).
+			Select("*").
+			Omit("id", "allocated_artifact_version", "artifact_version", "artifact_checksum", "lifecycle_state",
+				"created_at", "deleted_at", "deleted_by").
+			Updates(&m)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrStale
+		}
+		m.Id = before.Id
+		if err := replaceTargetEnvs(tx, &m); err != nil {
+			return err
+		}
+		after, err := registrationSnapshot(tx, "id = ?", before.Id)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, after.Id, models.AuditRegistrationUpdated, before, after)
+	})
+	return translateError(repo.db, err)
+}
+
+// SetCurrentArtifactVersion points the registration to another artifact version, along with its
+// checksum, without recording anything in the artifact history. It is meant for repairs, the
+// uploads go through CommitArtifactVersion. ErrStale is returned when the registration isn't at
+// rowVersion anymore, 0 skips the check.
+func (repo *ModelInfoRepository) SetCurrentArtifactVersion(modelName string, modelVersion string, artifactVersion string, checksum string, rowVersion int64) error {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		byKey := "model_name = ? AND model_version = ?"
+		before, err := registrationSnapshot(tx, byKey, modelName, modelVersion)
+		if err != nil {
+			return err
+		}
+		if before.DeletedAt.Valid {
+			return ErrNotFound
+		}
+		if rowVersion != 0 && rowVersion != before.RowVersion {
+			return ErrStale
+		}
+		res := tx.Model(&models.ModelRelatedInformation{}).
+			Where(byKey+" AND row_version = ?", modelName, modelVersion, before.RowVersion).
+			Updates(map[string]interface{}{
+				"artifact_version":  artifactVersion,
+				"artifact_checksum": checksum,
+				"row_version":       gorm.Expr("row_version + 1"),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrStale
+		}
+		after, err := registrationSnapshot(tx, "id = ?", before.Id)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, after.Id, models.AuditRegistrationUpdated, before, after)
+	})
+	return translateError(repo.db, err)
+}
+
+// Locks the registration row until the end of the transaction
+func lockRegistration(tx *gorm.DB, modelName string, modelVersion string) (*models.ModelRelatedInformation, error) {
+	var m models.ModelRelatedInformation
+	if err := lockForUpdate(tx).Session(&gorm.Session{SkipHooks: true}).
+		Where("model_name = ? AND model_version = ?", modelName, modelVersion).
+		First(&m).Error; err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// AllocateArtifactVersion hands out the next artifact version of the registration, as requested by
+// utils.NextArtifactVersion. The versions are allocated under the row lock, so concurrent uploads,
+// from any replica, never get the same bumped one. The same explicit version may be given to
+// several uploads, CommitArtifactVersion only records the first one. The allocation isn't audited,
+// the artifact is once committed.
+func (repo *ModelInfoRepository) AllocateArtifactVersion(modelName string, modelVersion string, requested string) (string, error) {
+	var allocated string
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		m, err := lockRegistration(tx, modelName, modelVersion)
+		if err != nil {
 			return err
 		}
-		return replaceTargetEnvs(tx, &m)
+		// the bumps skip the versions allocated to the uploads in progress while an explicit
+		// version only has to follow the committed one, so a failed upload can be retried with it
+		last := m.ModelId.ArtifactVersion
+		if utils.IsArtifactVersionBump(requested) && utils.CompareArtifactVersions(m.AllocatedArtifactVersion, last) > 0 {
+			last = m.AllocatedArtifactVersion
+		}
+		if allocated, err = utils.NextArtifactVersion(last, requested); err != nil {
+			return err
+		}
+		if utils.CompareArtifactVersions(allocated, m.AllocatedArtifactVersion) <= 0 {
+			return nil
+		}
+		return tx.Model(&models.ModelRelatedInformation{}).
+			Where("model_name = ? AND model_version = ?", modelName, modelVersion).
+			Update("allocated_artifact_version", allocated).Error
 	})
+	return allocated, translateError(repo.db, err)
 }
 
-func (repo *ModelInfoRepository) Delete(id string) (int64, error) {
+// CommitArtifactVersion records an allocated artifact version in the history of the registration.
+// The registration row stays locked while promote moves the artifact to its final key, so the
+// version is only recorded once the object exists. Uploads may complete out of order, an artifact older than the current one is
+// kept as a previous version and the current one is left untouched. An error from promote
+// leaves the registration untouched.
+func (repo *ModelInfoRepository) CommitArtifactVersion(modelName string, modelVersion string, artifact models.ArtifactVersion, promote func() error) (*models.ModelRelatedInformation, error) {
+	var committed *models.ModelRelatedInformation
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		m, err := lockRegistration(tx, modelName, modelVersion)
+		if err != nil {
+			return err
+		}
+		current := m.ModelId.ArtifactVersion
+		if artifact.Version == current || utils.CompareArtifactVersions(artifact.Version, m.AllocatedArtifactVersion) > 0 {
+			return ErrArtifactVersionConflict
+		}
+		// an explicit version may have been given to several uploads, only the first one is kept
+		var recorded int64
+		if err := tx.Model(&models.ArtifactVersion{}).
+			Where("model_related_information_id = ? AND version = ?", m.Id, artifact.Version).
+			Count(&recorded).Error; err != nil {
+			return err
+		}
+		if recorded > 0 {
+			return ErrArtifactVersionConflict
+		}
+		if promote != nil {
+			if err := promote(); err != nil {
+				return err
+			}
+		}
+
+		artifact.ModelRelatedInformationID = m.Id
+		artifact.Status = models.ArtifactStatusAvailable
+		if artifact.UploadedAt.IsZero() {
+			artifact.UploadedAt = time.Now()
+		}
+		before, err := registrationSnapshot(tx, "id = ?", m.Id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&artifact).Error; err != nil {
+			return err
+		}
+
+		committed = m
+		if utils.CompareArtifactVersions(artifact.Version, current) > 0 {
+			m.ModelId.ArtifactVersion = artifact.Version
+			m.ArtifactChecksum = artifact.Digest
+			if err := tx.Model(&models.ModelRelatedInformation{}).
+				Where("model_name = ? AND model_version = ?", modelName, modelVersion).
+				Updates(map[string]interface{}{
+					"artifact_version":  artifact.Version,
+					"artifact_checksum": artifact.Digest,
+					"row_version":       gorm.Expr("row_version + 1"),
+				}).Error; err != nil {
+				return err
+			}
+		}
+		after, err := registrationSnapshot(tx, "id = ?", m.Id)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, m.Id, models.AuditArtifactCommitted,
+			artifactCommitSnapshot{Registration: before, RowVersion: before.RowVersion},
+			artifactCommitSnapshot{Registration: after, RowVersion: after.RowVersion, Artifact: &artifact})
+	})
+	if err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	if err := attachEnvsOne(repo.db, committed); err != nil {
+		return nil, err
+	}
+	return committed, nil
+}
+
+// SELECT ... FOR UPDATE, sqlite has no row locks and serializes the writers instead
+func lockForUpdate(tx *gorm.DB) *gorm.DB {
+	if tx.Dialector.Name() == "sqlite" {
+		return tx
+	}
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"})
+}
+
+// Delete marks the registration as deleted by the actor, it is kept along with its history until purged.
+// When rowVersion is set, the registration is only deleted if it is still at that row version, ErrStale
+// is returned otherwise.
+func (repo *ModelInfoRepository) Delete(id string, deletedBy string, rowVersion int64) (int64, error) {
 	var rows int64
 	err := repo.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("model_related_information_id = ?", id).
-			Delete(&models.TargetEnvironment{}).
-			Error; err != nil {
+		before, err := registrationSnapshot(tx, "id = ?", id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
 			return err
 		}
-		res := tx.Delete(&models.ModelRelatedInformation{}, "id = ?", id)
+		if rowVersion == 0 {
+			rowVersion = before.RowVersion
+		}
+		if rowVersion != before.RowVersion && !before.DeletedAt.Valid {
+			return ErrStale
+		}
+		res := tx.Model(&models.ModelRelatedInformation{}).
+			Where("id = ? AND row_version = ?", id, rowVersion).
+			Updates(map[string]interface{}{
+				"deleted_at":  time.Now(),
+				"deleted_by":  deletedBy,
+				"row_version": gorm.Expr("row_version + 1"),
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
 		rows = res.RowsAffected
-		return res.Error
+		after, err := registrationSnapshot(tx, "id = ?", id)
+		if err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, id, models.AuditRegistrationDeleted, before, after)
 	})
-	return rows, err
+	return rows, translateError(repo.db, err)
+}
+
+// Restore brings back a deleted registration, ErrNotFound is returned
+// when there is no deleted registration with this id
+func (repo *ModelInfoRepository) Restore(id string) (*models.ModelRelatedInformation, error) {
+	var restored *models.ModelRelatedInformation
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		before, err := registrationSnapshot(tx, "id = ? AND deleted_at IS NOT NULL", id)
+		if err != nil {
+			return err
+		}
+		res := tx.Unscoped().Model(&models.ModelRelatedInformation{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at":  nil,
+				"deleted_by":  "",
+				"row_version": gorm.Expr("row_version + 1"),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		if restored, err = registrationSnapshot(tx, "id = ?", id); err != nil {
+			return err
+		}
+		return repo.recordAudit(tx, id, models.AuditRegistrationRestored, before, restored)
+	})
+	if err != nil {
+		return nil, translateError(repo.db, err)
+	}
+	return restored, nil
+}
+
+// ListDeleted returns the registrations deleted before the given time, oldest first
+func (repo *ModelInfoRepository) ListDeleted(deletedBefore time.Time) ([]models.ModelRelatedInformation, error) {
+	var modelInfos []models.ModelRelatedInformation
+	if err := repo.db.Session(&gorm.Session{SkipHooks: true}).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Order("deleted_at").
+		Find(&modelInfos).Error; err != nil {
+		return nil, err
+	}
+	return modelInfos, nil
+}
+
+// Purge permanently removes a deleted registration along with its target environments, artifact
+// history, aliases and lifecycle transitions. Registrations which are not deleted are left untouched.
+// The audit trail of the registration is kept.
+func (repo *ModelInfoRepository) Purge(id string) (int64, error) {
+	var rows int64
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		before, err := registrationSnapshot(tx, "id = ? AND deleted_at IS NOT NULL", id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res := tx.Unscoped().Delete(&models.ModelRelatedInformation{}, "id = ? AND deleted_at IS NOT NULL", id)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		rows = res.RowsAffected
+		for _, child := range []interface{}{
+			&models.TargetEnvironment{},
+			&models.ArtifactVersion{},
+			&models.ArtifactAlias{},
+			&models.LifecycleTransition{},
+		} {
+			if err := tx.Where("model_related_information_id = ?", id).Delete(child).Error; err != nil {
+				return err
+			}
+		}
+		return repo.recordAudit(tx, id, models.AuditRegistrationPurged, before, nil)
+	})
+	return rows, translateError(repo.db, err)
 }
 
 func (repo *ModelInfoRepository) GetModelInfoByName(modelName string) ([]models.ModelRelatedInformation, error) {
@@ -122,7 +461,7 @@ func (repo *ModelInfoRepository) GetModelInfoByNameAndVer(modelName string, mode
 	if err := repo.db.Session(&gorm.Session{SkipHooks: true}).
 		Where("model_name = ? AND model_version = ?", modelName, modelVersion).
 		First(&m).Error; err != nil {
-		return nil, err
+		return nil, translateError(repo.db, err)
 	}
 	if err := attachEnvsOne(repo.db, &m); err != nil {
 		return nil, err
@@ -136,7 +475,7 @@ func (repo *ModelInfoRepository) GetModelInfoById(id string) (*models.ModelRelat
 	if err := repo.db.Session(&gorm.Session{SkipHooks: true}).
 		Where("id = ?", id).
 		First(&m).Error; err != nil {
-		return nil, err
+		return nil, translateError(repo.db, err)
 	}
 	if err := attachEnvsOne(repo.db, &m); err != nil {
 		return nil, err
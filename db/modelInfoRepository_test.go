@@ -18,26 +18,58 @@ limitations under the License.
 package db
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
-	"github.com/glebarez/sqlite"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/utils"
 	"gorm.io/gorm"
 )
 
+var resetTestDB sync.Once
+
+/*
+Opens the database the suite runs against, SQLite in memory by default. Set TEST_DB_DRIVER=postgres
+along with the PG_* variables to run it against Postgres, the tables are then emptied once so the
+registrations of a previous run don't conflict.
+*/
 func openTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	d, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	dbConfig := config.DBConfigData{
+		DB_DRIVER:   os.Getenv("TEST_DB_DRIVER"),
+		SQLITE_PATH: "file::memory:?cache=shared",
+		PG_HOST:     os.Getenv("PG_HOST"),
+		PG_PORT:     os.Getenv("PG_PORT"),
+		PG_USER:     os.Getenv("PG_USER"),
+		PG_PASSWORD: os.Getenv("PG_PASSWORD"),
+		PG_DBNAME:   os.Getenv("PG_DBNAME"),
+	}
+	if dbConfig.DB_DRIVER == "" {
+		dbConfig.DB_DRIVER = config.DB_DRIVER_SQLITE
+	}
+	d, err := Open(dbConfig)
 	if err != nil {
-		t.Fatalf("open sqlite: %v", err)
+		t.Fatalf("open %s: %v", dbConfig.DB_DRIVER, err)
 	}
-
-	if err := d.AutoMigrate(
-		&models.ModelRelatedInformation{},
-		&models.TargetEnvironment{},
-	); err != nil {
-		t.Fatalf("automigrate: %v", err)
+	if err := Migrate(d); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	resetTestDB.Do(func() {
+		if dbConfig.DB_DRIVER != config.DB_DRIVER_POSTGRES {
+			return
+		}
+		// TRUNCATE isn't blocked by the rules protecting the audit trail
+		err = d.Exec("TRUNCATE model_related_informations, target_environments, artifact_versions, artifact_aliases, lifecycle_transitions, audit_events").Error
+	})
+	if err != nil {
+		t.Fatalf("reset: %v", err)
 	}
 	return d
 }
@@ -84,6 +116,12 @@ func TestCRUD_Subtests(t *testing.T) {
 		id = got.Id
 	})
 
+	t.Run("Create_duplicate", func(t *testing.T) {
+		if err := repo.Create(mkMRI("resnet", "1.0.0", nil)); !errors.Is(err, ErrConflict) {
+			t.Fatalf("want ErrConflict, got %v", err)
+		}
+	})
+
 	t.Run("Read", func(t *testing.T) {
 		byID, err := repo.GetModelInfoById(id)
 		if err != nil {
@@ -170,7 +208,7 @@ func TestCRUD_Subtests(t *testing.T) {
 	})
 
 	t.Run("Delete", func(t *testing.T) {
-		rows, err := repo.Delete(id)
+		rows, err := repo.Delete(id, "tester", 0)
 		if err != nil {
 			t.Fatalf("delete: %v", err)
 		}
@@ -182,3 +220,772 @@ func TestCRUD_Subtests(t *testing.T) {
 		}
 	})
 }
+
+func TestArtifactVersionAllocationAndCommit(t *testing.T) {
+	repo := newRepo(t)
+	m := mkMRI("yolo", "1", nil)
+	m.ModelId.ArtifactVersion = "1.0.0"
+	if err := repo.Create(m); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	t.Run("Allocate_distinct_versions", func(t *testing.T) {
+		first, err := repo.AllocateArtifactVersion("yolo", "1", "")
+		if err != nil {
+			t.Fatalf("allocate: %v", err)
+		}
+		second, err := repo.AllocateArtifactVersion("yolo", "1", "")
+		if err != nil {
+			t.Fatalf("allocate: %v", err)
+		}
+		if first != "1.1.0" || second != "1.2.0" {
+			t.Fatalf("want 1.1.0 and 1.2.0, got %s and %s", first, second)
+		}
+	})
+
+	t.Run("Update_keeps_allocation", func(t *testing.T) {
+		cur, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		cur.AllocatedArtifactVersion = ""
+		if err := repo.Update(*cur); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		next, err := repo.AllocateArtifactVersion("yolo", "1", "")
+		if err != nil || next != "1.3.0" {
+			t.Fatalf("want 1.3.0, got %s (%v)", next, err)
+		}
+	})
+
+	t.Run("Allocate_requested_versions", func(t *testing.T) {
+		patch, err := repo.AllocateArtifactVersion("yolo", "1", "patch")
+		if err != nil || patch != "1.3.1" {
+			t.Fatalf("want 1.3.1, got %s (%v)", patch, err)
+		}
+		if _, err := repo.AllocateArtifactVersion("yolo", "1", "1.0.0"); !errors.Is(err, utils.ErrArtifactVersionNotIncreased) {
+			t.Fatalf("want ErrArtifactVersionNotIncreased, got %v", err)
+		}
+		if _, err := repo.AllocateArtifactVersion("yolo", "1", "v2"); !errors.Is(err, utils.ErrInvalidArtifactVersion) {
+			t.Fatalf("want ErrInvalidArtifactVersion, got %v", err)
+		}
+		explicit, err := repo.AllocateArtifactVersion("yolo", "1", "1.3.5")
+		if err != nil || explicit != "1.3.5" {
+			t.Fatalf("want 1.3.5, got %s (%v)", explicit, err)
+		}
+	})
+
+	t.Run("Explicit_version_follows_committed", func(t *testing.T) {
+		// 1.1.0 is allocated but never committed, an upload may retry with it
+		retried, err := repo.AllocateArtifactVersion("yolo", "1", "1.1.0")
+		if err != nil || retried != "1.1.0" {
+			t.Fatalf("want 1.1.0, got %s (%v)", retried, err)
+		}
+		// the bumps still skip the versions allocated so far
+		next, err := repo.AllocateArtifactVersion("yolo", "1", "patch")
+		if err != nil || next != "1.3.6" {
+			t.Fatalf("want 1.3.6, got %s (%v)", next, err)
+		}
+	})
+
+	t.Run("Promote_failure_keeps_version", func(t *testing.T) {
+		promoteErr := errors.New("copy failed")
+		_, err := repo.CommitArtifactVersion("yolo", "1", models.ArtifactVersion{Version: "1.1.0", Digest: "abc"}, func() error { return promoteErr })
+		if !errors.Is(err, promoteErr) {
+			t.Fatalf("want promote error, got %v", err)
+		}
+		got, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		if got.ModelId.ArtifactVersion != "1.0.0" {
+			t.Fatalf("want 1.0.0 kept, got %s", got.ModelId.ArtifactVersion)
+		}
+	})
+
+	t.Run("Commit_out_of_order", func(t *testing.T) {
+		got, err := repo.CommitArtifactVersion("yolo", "1", models.ArtifactVersion{Version: "1.2.0", Digest: "abc"}, nil)
+		if err != nil || got.ModelId.ArtifactVersion != "1.2.0" || got.ArtifactChecksum != "abc" {
+			t.Fatalf("commit 1.2.0: %v, %+v", err, got)
+		}
+		promoted := false
+		got, err = repo.CommitArtifactVersion("yolo", "1", models.ArtifactVersion{Version: "1.1.0", Digest: "def"}, func() error {
+			promoted = true
+			return nil
+		})
+		if err != nil || !promoted {
+			t.Fatalf("commit 1.1.0: %v, promoted: %v", err, promoted)
+		}
+		// the older artifact is kept, the current one doesn't go back
+		if got.ModelId.ArtifactVersion != "1.2.0" || got.ArtifactChecksum != "abc" {
+			t.Fatalf("unexpected registration after commit: %+v", got)
+		}
+	})
+
+	t.Run("Commit_rejects_unallocated_and_recorded", func(t *testing.T) {
+		for _, artifactVersion := range []string{"1.1.0", "1.2.0", "1.4.0", "2.0.0"} {
+			_, err := repo.CommitArtifactVersion("yolo", "1", models.ArtifactVersion{Version: artifactVersion}, func() error {
+				t.Fatalf("promote must not run on conflict")
+				return nil
+			})
+			if !errors.Is(err, ErrArtifactVersionConflict) {
+				t.Fatalf("%s: want ErrArtifactVersionConflict, got %v", artifactVersion, err)
+			}
+		}
+	})
+
+	t.Run("Update_keeps_artifact", func(t *testing.T) {
+		cur, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		cur.ModelId.ArtifactVersion = "9.0.0"
+		cur.ArtifactChecksum = ""
+		cur.ModelLocation = "elsewhere"
+		if err := repo.Update(*cur); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		got, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		if got.ModelId.ArtifactVersion != "1.2.0" || got.ArtifactChecksum != "abc" || got.ModelLocation != "elsewhere" {
+			t.Fatalf("unexpected registration after update: %+v", got)
+		}
+	})
+
+	t.Run("Set_current_artifact_version", func(t *testing.T) {
+		cur, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		if err := repo.SetCurrentArtifactVersion("yolo", "1", "1.1.0", "def", cur.RowVersion+1); !errors.Is(err, ErrStale) {
+			t.Fatalf("want ErrStale, got %v", err)
+		}
+		if err := repo.SetCurrentArtifactVersion("yolo", "1", "1.1.0", "def", cur.RowVersion); err != nil {
+			t.Fatalf("set current: %v", err)
+		}
+		got, _ := repo.GetModelInfoByNameAndVer("yolo", "1")
+		if got.ModelId.ArtifactVersion != "1.1.0" || got.ArtifactChecksum != "def" || got.RowVersion != cur.RowVersion+1 {
+			t.Fatalf("unexpected registration after set: %+v", got)
+		}
+	})
+}
+
+func TestArtifactVersionHistory(t *testing.T) {
+	repo := newRepo(t)
+	m := mkMRI("history", "1", nil)
+	m.ModelId.ArtifactVersion = "0.0.0"
+	if err := repo.Create(m); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("history", "1")
+	for i := 0; i < 10; i++ {
+		artifactVersion, err := repo.AllocateArtifactVersion("history", "1", "")
+		if err != nil {
+			t.Fatalf("allocate: %v", err)
+		}
+		if _, err := repo.CommitArtifactVersion("history", "1", models.ArtifactVersion{
+			Version:    artifactVersion,
+			ObjectName: "history_1_" + artifactVersion + ".zip",
+			Size:       int64(i),
+			Digest:     "abc",
+			UploadedBy: "tester",
+		}, nil); err != nil {
+			t.Fatalf("commit %s: %v", artifactVersion, err)
+		}
+	}
+
+	t.Run("List_in_version_order", func(t *testing.T) {
+		artifacts, err := repo.ListArtifactVersions(cur.Id)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(artifacts) != 10 {
+			t.Fatalf("want 10 artifacts, got %d", len(artifacts))
+		}
+		if artifacts[0].Version != "1.0.0" || artifacts[9].Version != "1.9.0" {
+			t.Fatalf("unexpected order: %s ... %s", artifacts[0].Version, artifacts[9].Version)
+		}
+		if artifacts[9].Status != models.ArtifactStatusAvailable || artifacts[9].UploadedBy != "tester" || artifacts[9].UploadedAt.IsZero() {
+			t.Fatalf("unexpected artifact: %+v", artifacts[9])
+		}
+	})
+
+	t.Run("Mark", func(t *testing.T) {
+		artifact, err := repo.SetArtifactVersionStatus(cur.Id, "1.2.0", models.ArtifactStatusRevoked)
+		if err != nil || artifact.Status != models.ArtifactStatusRevoked {
+			t.Fatalf("mark: %v, %+v", err, artifact)
+		}
+		got, err := repo.GetArtifactVersion(cur.Id, "1.2.0")
+		if err != nil || got.Status != models.ArtifactStatusRevoked || got.Size != 2 {
+			t.Fatalf("get: %v, %+v", err, got)
+		}
+		if _, err := repo.SetArtifactVersionStatus(cur.Id, "9.9.9", models.ArtifactStatusRevoked); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Purged_with_registration", func(t *testing.T) {
+		if _, err := repo.Delete(cur.Id, "tester", 0); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if rows, err := repo.Purge(cur.Id); err != nil || rows != 1 {
+			t.Fatalf("purge: %d, %v", rows, err)
+		}
+		artifacts, err := repo.ListArtifactVersions(cur.Id)
+		if err != nil || len(artifacts) != 0 {
+			t.Fatalf("want no artifacts left, got %d (%v)", len(artifacts), err)
+		}
+	})
+}
+
+func TestArtifactAliases(t *testing.T) {
+	repo := newRepo(t)
+	m := mkMRI("alias", "1", nil)
+	m.ModelId.ArtifactVersion = "0.0.0"
+	if err := repo.Create(m); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("alias", "1")
+
+	t.Run("No_latest_before_upload", func(t *testing.T) {
+		if _, err := repo.ResolveArtifactAlias("alias", "1", models.AliasLatest); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+	})
+
+	for range 2 {
+		artifactVersion, err := repo.AllocateArtifactVersion("alias", "1", "")
+		if err != nil {
+			t.Fatalf("allocate: %v", err)
+		}
+		if _, err := repo.CommitArtifactVersion("alias", "1", models.ArtifactVersion{Version: artifactVersion}, nil); err != nil {
+			t.Fatalf("commit %s: %v", artifactVersion, err)
+		}
+	}
+
+	t.Run("Latest_follows_uploads", func(t *testing.T) {
+		got, err := repo.ResolveArtifactAlias("alias", "1", models.AliasLatest)
+		if err != nil || got != "1.1.0" {
+			t.Fatalf("want 1.1.0, got %s (%v)", got, err)
+		}
+	})
+
+	t.Run("Set_and_move", func(t *testing.T) {
+		if _, err := repo.SetArtifactAlias(cur.Id, "production", "1.0.0"); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+		if _, err := repo.SetArtifactAlias(cur.Id, "production", "1.1.0"); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+		got, err := repo.ResolveArtifactAlias("alias", "1", "production")
+		if err != nil || got != "1.1.0" {
+			t.Fatalf("want 1.1.0, got %s (%v)", got, err)
+		}
+		aliases, err := repo.ListArtifactAliases(cur.Id)
+		if err != nil || len(aliases) != 2 || aliases[0].Name != models.AliasLatest || aliases[1].Name != "production" {
+			t.Fatalf("unexpected aliases: %+v (%v)", aliases, err)
+		}
+	})
+
+	t.Run("Set_rejects_unknown_and_revoked", func(t *testing.T) {
+		if _, err := repo.SetArtifactAlias(cur.Id, "staging", "9.9.9"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+		if _, err := repo.SetArtifactVersionStatus(cur.Id, "1.0.0", models.ArtifactStatusRevoked); err != nil {
+			t.Fatalf("revoke: %v", err)
+		}
+		if _, err := repo.SetArtifactAlias(cur.Id, "staging", "1.0.0"); !errors.Is(err, ErrArtifactVersionRevoked) {
+			t.Fatalf("want ErrArtifactVersionRevoked, got %v", err)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		rows, err := repo.DeleteArtifactAlias(cur.Id, "production")
+		if err != nil || rows != 1 {
+			t.Fatalf("delete: %d rows (%v)", rows, err)
+		}
+		if _, err := repo.ResolveArtifactAlias("alias", "1", "production"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestLifecycleTransitions(t *testing.T) {
+	repo := newRepo(t)
+	if err := repo.Create(mkMRI("lifecycle", "1", nil)); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("lifecycle", "1")
+	if cur.LifecycleState != models.LifecycleRegistered {
+		t.Fatalf("want registered, got %q", cur.LifecycleState)
+	}
+
+	t.Run("Follow_graph", func(t *testing.T) {
+		for _, state := range []string{models.LifecycleTrained, models.LifecycleValidated, models.LifecycleDeployed} {
+			if _, err := repo.TransitionLifecycleState(cur.Id, state, "tester", "ok"); err != nil {
+				t.Fatalf("transition to %s: %v", state, err)
+			}
+		}
+		got, _ := repo.GetModelInfoById(cur.Id)
+		if got.LifecycleState != models.LifecycleDeployed {
+			t.Fatalf("want deployed, got %s", got.LifecycleState)
+		}
+	})
+
+	t.Run("Reject_outside_graph", func(t *testing.T) {
+		if _, err := repo.TransitionLifecycleState(cur.Id, models.LifecycleArchived, "tester", ""); !errors.Is(err, ErrLifecycleTransition) {
+			t.Fatalf("want ErrLifecycleTransition, got %v", err)
+		}
+	})
+
+	t.Run("Update_keeps_state", func(t *testing.T) {
+		got, _ := repo.GetModelInfoById(cur.Id)
+		got.LifecycleState = models.LifecycleRegistered
+		if err := repo.Update(*got); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		got, _ = repo.GetModelInfoById(cur.Id)
+		if got.LifecycleState != models.LifecycleDeployed {
+			t.Fatalf("want deployed kept, got %s", got.LifecycleState)
+		}
+	})
+
+	t.Run("History", func(t *testing.T) {
+		transitions, err := repo.ListLifecycleTransitions(cur.Id)
+		if err != nil || len(transitions) != 3 {
+			t.Fatalf("want 3 transitions, got %d (%v)", len(transitions), err)
+		}
+		if transitions[0].FromState != models.LifecycleRegistered || transitions[0].Actor != "tester" || transitions[0].Reason != "ok" {
+			t.Fatalf("unexpected transition: %+v", transitions[0])
+		}
+	})
+}
+
+func TestFind(t *testing.T) {
+	repo := newRepo(t)
+	for i, artifactVersion := range []string{"1.9.0", "1.10.0", "2.0.0", "0.0.0"} {
+		m := mkMRI("find", fmt.Sprintf("v%d", i), []models.TargetEnvironment{{PlatformName: "k8s", EnvironmentType: "prod", DependencyList: "x"}})
+		m.ModelId.ArtifactVersion = artifactVersion
+		m.ModelInformation.InputDataType = "pdcpBytesDl, pdcpBytesUl"
+		if i%2 == 1 {
+			m.ModelInformation.Metadata.Author = "other"
+			m.ModelInformation.TargetEnvironment[0].PlatformName = "edge"
+		}
+		if err := repo.Create(m); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	if err := repo.Create(mkMRI("unrelated", "1", nil)); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	t.Run("Filters", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			query models.ModelInfoQuery
+			want  int64
+		}{
+			"Name":          {models.ModelInfoQuery{ModelName: "find"}, 4},
+			"Author":        {models.ModelInfoQuery{Author: "other"}, 2},
+			"InputDataType": {models.ModelInfoQuery{InputDataType: "pdcpBytesUl"}, 4},
+			"PartialType":   {models.ModelInfoQuery{InputDataType: "pdcpBytes"}, 0},
+			"Platform":      {models.ModelInfoQuery{PlatformName: "edge", EnvironmentType: "prod"}, 2},
+			"State":         {models.ModelInfoQuery{ModelName: "find", LifecycleState: models.LifecycleRegistered}, 4},
+		} {
+			got, total, err := repo.Find(tc.query)
+			if err != nil || total != tc.want || int64(len(got)) != tc.want {
+				t.Fatalf("%s: want %d, got %d/%d (%v)", name, tc.want, len(got), total, err)
+			}
+		}
+	})
+
+	t.Run("Sort_artifact_version_and_page", func(t *testing.T) {
+		got, total, err := repo.Find(models.ModelInfoQuery{
+			ModelName: "find",
+			Sort:      []models.SortField{{Field: models.SortArtifactVersion, Desc: true}},
+			Limit:     2,
+			Offset:    1,
+		})
+		if err != nil || total != 4 || len(got) != 2 {
+			t.Fatalf("want 2 of 4, got %d/%d (%v)", len(got), total, err)
+		}
+		if got[0].ModelId.ArtifactVersion != "1.10.0" || got[1].ModelId.ArtifactVersion != "1.9.0" {
+			t.Fatalf("unexpected order: %s, %s", got[0].ModelId.ArtifactVersion, got[1].ModelId.ArtifactVersion)
+		}
+		if len(got[0].ModelInformation.TargetEnvironment) != 1 {
+			t.Fatalf("target environments not attached")
+		}
+	})
+}
+
+func TestSearch(t *testing.T) {
+	repo := newRepo(t)
+	for i, description := range []string{
+		"Forecasts the Quokka throughput of a cell",
+		"Detects quokka anomalies, quokka alarms included",
+		"Unrelated description",
+	} {
+		m := mkMRI("search", fmt.Sprintf("v%d", i), []models.TargetEnvironment{{PlatformName: "k8s", EnvironmentType: "prod", DependencyList: "x"}})
+		m.Description = description
+		if i == 2 {
+			m.ModelInformation.Metadata.Owner = "quokka-team"
+			m.ModelInformation.InputDataType = "wallabyBytesDl,wallabyBytesUl"
+		}
+		if err := repo.Create(m); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	t.Run("Ranked", func(t *testing.T) {
+		got, total, err := repo.Search(models.SearchQuery{Text: "quokka"})
+		if err != nil || total != 3 || len(got) != 3 {
+			t.Fatalf("want 3, got %d/%d (%v)", len(got), total, err)
+		}
+		if got[0].ModelInfo.ModelId.ModelVersion != "v1" || got[0].Rank <= got[1].Rank {
+			t.Fatalf("unexpected order: %s (%v), %s (%v)", got[0].ModelInfo.ModelId.ModelVersion, got[0].Rank, got[1].ModelInfo.ModelId.ModelVersion, got[1].Rank)
+		}
+		if !strings.Contains(got[0].Snippet, "<mark>quokka</mark> anomalies") {
+			t.Fatalf("snippet not highlighted: %q", got[0].Snippet)
+		}
+		if len(got[0].ModelInfo.ModelInformation.TargetEnvironment) != 1 {
+			t.Fatalf("target environments not attached")
+		}
+	})
+
+	t.Run("All_words_and_data_types", func(t *testing.T) {
+		got, total, err := repo.Search(models.SearchQuery{Text: "Quokka wallabyBytesUl"})
+		if err != nil || total != 1 || got[0].ModelInfo.ModelId.ModelVersion != "v2" {
+			t.Fatalf("want v2 only, got %d (%v)", total, err)
+		}
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		got, total, err := repo.Search(models.SearchQuery{Text: "quokka", Limit: 1, Offset: 2})
+		if err != nil || total != 3 || len(got) != 1 {
+			t.Fatalf("want 1 of 3, got %d/%d (%v)", len(got), total, err)
+		}
+	})
+}
+
+func TestSoftDelete(t *testing.T) {
+	repo := newRepo(t)
+	m := mkMRI("tombstone", "1", []models.TargetEnvironment{{PlatformName: "k8s", EnvironmentType: "prod", DependencyList: "x"}})
+	if err := repo.Create(m); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("tombstone", "1")
+
+	t.Run("Delete", func(t *testing.T) {
+		if rows, err := repo.Delete(cur.Id, "alice", 0); err != nil || rows != 1 {
+			t.Fatalf("delete: %d, %v", rows, err)
+		}
+		if rows, err := repo.Delete(cur.Id, "alice", 0); err != nil || rows != 0 {
+			t.Fatalf("want nothing deleted twice, got %d, %v", rows, err)
+		}
+		if _, err := repo.GetModelInfoById(cur.Id); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+		if got, total, err := repo.Find(models.ModelInfoQuery{ModelName: "tombstone"}); err != nil || total != 0 || len(got) != 0 {
+			t.Fatalf("want deleted registration hidden, got %d (%v)", total, err)
+		}
+		got, total, err := repo.Find(models.ModelInfoQuery{ModelName: "tombstone", IncludeDeleted: true})
+		if err != nil || total != 1 || got[0].DeletedBy != "alice" || !got[0].DeletedAt.Valid {
+			t.Fatalf("want deleted registration listed, got %d (%v)", total, err)
+		}
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		restored, err := repo.Restore(cur.Id)
+		if err != nil || restored.DeletedBy != "" || len(restored.ModelInformation.TargetEnvironment) != 1 {
+			t.Fatalf("restore: %v, %+v", err, restored)
+		}
+		if _, err := repo.Restore(cur.Id); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+		if rows, err := repo.Purge(cur.Id); err != nil || rows != 0 {
+			t.Fatalf("want a live registration not purged, got %d, %v", rows, err)
+		}
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		if _, err := repo.Delete(cur.Id, "alice", 0); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if deleted, err := repo.ListDeleted(time.Now().Add(-time.Hour)); err != nil || containsId(deleted, cur.Id) {
+			t.Fatalf("want registration within its retention, got %v", err)
+		}
+		deleted, err := repo.ListDeleted(time.Now().Add(time.Second))
+		if err != nil || !containsId(deleted, cur.Id) {
+			t.Fatalf("want registration past its retention, got %v", err)
+		}
+		if rows, err := repo.Purge(cur.Id); err != nil || rows != 1 {
+			t.Fatalf("purge: %d, %v", rows, err)
+		}
+		if got, total, _ := repo.Find(models.ModelInfoQuery{ModelName: "tombstone", IncludeDeleted: true}); total != 0 || len(got) != 0 {
+			t.Fatalf("want registration gone, got %d", total)
+		}
+		if err := repo.Create(mkMRI("tombstone", "1", nil)); err != nil {
+			t.Fatalf("register again after purge: %v", err)
+		}
+	})
+}
+
+func containsId(modelInfos []models.ModelRelatedInformation, id string) bool {
+	for _, modelInfo := range modelInfos {
+		if modelInfo.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAuditTrail(t *testing.T) {
+	repo := newRepo(t).WithAudit(models.AuditContext{Actor: "alice", RequestId: "req-1"})
+	m := mkMRI("audited", "1", nil)
+	m.ModelId.ArtifactVersion = "0.0.0"
+	if err := repo.Create(m); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("audited", "1")
+
+	updated := *cur
+	updated.Description = "changed"
+	if err := repo.Update(updated); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := repo.AllocateArtifactVersion("audited", "1", ""); err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+	if _, err := repo.CommitArtifactVersion("audited", "1", models.ArtifactVersion{Version: "1.0.0"}, nil); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if _, err := repo.SetArtifactAlias(cur.Id, "production", "1.0.0"); err != nil {
+		t.Fatalf("alias: %v", err)
+	}
+	if _, err := repo.DeleteArtifactAlias(cur.Id, "production"); err != nil {
+		t.Fatalf("unalias: %v", err)
+	}
+	if _, err := repo.TransitionLifecycleState(cur.Id, models.LifecycleTrained, "alice", ""); err != nil {
+		t.Fatalf("transition: %v", err)
+	}
+	if _, err := repo.Delete(cur.Id, "alice", 0); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := repo.Purge(cur.Id); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+
+	events, err := repo.ListAuditEvents(cur.Id)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	actions := []string{}
+	for _, event := range events {
+		actions = append(actions, event.Action)
+		if event.Actor != "alice" || event.RequestId != "req-1" {
+			t.Fatalf("%s: unexpected actor %q, request %q", event.Action, event.Actor, event.RequestId)
+		}
+	}
+	want := []string{
+		models.AuditRegistrationCreated,
+		models.AuditRegistrationUpdated,
+		models.AuditArtifactCommitted,
+		models.AuditAliasSet,
+		models.AuditAliasDeleted,
+		models.AuditLifecycleTransition,
+		models.AuditRegistrationDeleted,
+		models.AuditRegistrationPurged,
+	}
+	if strings.Join(actions, ",") != strings.Join(want, ",") {
+		t.Fatalf("want %v, got %v", want, actions)
+	}
+	if events[0].Before != "" || !strings.Contains(string(events[0].After), `"modelName":"audited"`) {
+		t.Fatalf("unexpected creation snapshots: %s, %s", events[0].Before, events[0].After)
+	}
+	if events[1].Diff != `{"description":"changed"}` {
+		t.Fatalf("unexpected update diff: %s", events[1].Diff)
+	}
+	commit := string(events[2].Diff)
+	if !strings.Contains(commit, `"registration":{"modelId":{"artifactVersion":"1.0.0"}}`) || !strings.Contains(commit, `"rowVersion":3`) ||
+		!strings.Contains(string(events[2].Before), `"rowVersion":2`) {
+		t.Fatalf("unexpected commit snapshots: %s, %s", events[2].Before, events[2].Diff)
+	}
+	if events[5].Diff != `{"lifecycleState":"trained"}` {
+		t.Fatalf("unexpected transition diff: %s", events[5].Diff)
+	}
+	if events[7].After != "" || events[7].Diff != "null" {
+		t.Fatalf("unexpected purge snapshots: %s, %s", events[7].After, events[7].Diff)
+	}
+}
+
+func TestOptimisticConcurrency(t *testing.T) {
+	repo := newRepo(t)
+	if err := repo.Create(mkMRI("versioned", "1", nil)); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	cur, _ := repo.GetModelInfoByNameAndVer("versioned", "1")
+	if cur.RowVersion != 1 {
+		t.Fatalf("want row version 1, got %d", cur.RowVersion)
+	}
+
+	t.Run("Update_bumps_version", func(t *testing.T) {
+		m := *cur
+		m.Description = "first"
+		if err := repo.Update(m); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		got, _ := repo.GetModelInfoById(cur.Id)
+		if got.RowVersion != 2 || got.Description != "first" {
+			t.Fatalf("want version 2 with new description, got %d %q", got.RowVersion, got.Description)
+		}
+	})
+
+	t.Run("Stale_update", func(t *testing.T) {
+		m := *cur
+		m.Description = "lost"
+		if err := repo.Update(m); !errors.Is(err, ErrStale) {
+			t.Fatalf("want ErrStale, got %v", err)
+		}
+		got, _ := repo.GetModelInfoById(cur.Id)
+		if got.Description != "first" {
+			t.Fatalf("stale update must not apply, got %q", got.Description)
+		}
+	})
+
+	t.Run("Unconditional_update", func(t *testing.T) {
+		m := *cur
+		m.RowVersion = 0
+		m.Description = "forced"
+		if err := repo.Update(m); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	})
+
+	t.Run("Lifecycle_bumps_version", func(t *testing.T) {
+		before, _ := repo.GetModelInfoById(cur.Id)
+		if _, err := repo.TransitionLifecycleState(cur.Id, models.LifecycleTrained, "tester", ""); err != nil {
+			t.Fatalf("transition: %v", err)
+		}
+		got, _ := repo.GetModelInfoById(cur.Id)
+		if got.RowVersion != before.RowVersion+1 {
+			t.Fatalf("want version %d, got %d", before.RowVersion+1, got.RowVersion)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		got, _ := repo.GetModelInfoById(cur.Id)
+		if _, err := repo.Delete(cur.Id, "alice", got.RowVersion-1); !errors.Is(err, ErrStale) {
+			t.Fatalf("want ErrStale, got %v", err)
+		}
+		if rows, err := repo.Delete(cur.Id, "alice", got.RowVersion); err != nil || rows != 1 {
+			t.Fatalf("delete: %d, %v", rows, err)
+		}
+	})
+
+	t.Run("Update_missing", func(t *testing.T) {
+		if err := repo.Update(mkMRI("versioned", "missing", nil)); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("want ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	// a private database, the migrations are reverted
+	d, err := Open(config.DBConfigData{DB_DRIVER: config.DB_DRIVER_SQLITE, SQLITE_PATH: ":memory:"})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	migrations, err := Migrations("sqlite")
+	if err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+
+	t.Run("up_to_target", func(t *testing.T) {
+		applied, err := MigrateUp(d, 1)
+		if err != nil || len(applied) != 1 || applied[0].Version != 1 {
+			t.Fatalf("up to 1: %v %v", applied, err)
+		}
+		if d.Migrator().HasTable(&models.ArtifactVersion{}) {
+			t.Fatalf("artifact_versions is created by migration 2")
+		}
+		// registered by the previous release
+		err = d.Exec("INSERT INTO model_related_informations (id, model_name, model_version, artifact_version) VALUES ('old', 'old', '1', '1.2.0')").Error
+		if err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	})
+
+	t.Run("up", func(t *testing.T) {
+		applied, err := MigrateUp(d, 0)
+		if err != nil || len(applied) != len(migrations)-1 {
+			t.Fatalf("up: %v %v", applied, err)
+		}
+		applied, err = MigrateUp(d, 0)
+		if err != nil || len(applied) != 0 {
+			t.Fatalf("up again: %v %v", applied, err)
+		}
+		var allocated string
+		if err := d.Raw("SELECT allocated_artifact_version FROM model_related_informations WHERE id = 'old'").Scan(&allocated).Error; err != nil || allocated != "1.2.0" {
+			t.Fatalf("allocation not backfilled: %q %v", allocated, err)
+		}
+	})
+
+	t.Run("schema_matches_models", func(t *testing.T) {
+		for _, model := range []any{
+			&models.ModelRelatedInformation{},
+			&models.TargetEnvironment{},
+			&models.ArtifactVersion{},
+			&models.ArtifactAlias{},
+			&models.LifecycleTransition{},
+			&models.AuditEvent{},
+		} {
+			stmt := &gorm.Statement{DB: d}
+			if err := stmt.Parse(model); err != nil {
+				t.Fatalf("parse %T: %v", model, err)
+			}
+			for _, field := range stmt.Schema.Fields {
+				if field.DBName != "" && !d.Migrator().HasColumn(model, field.DBName) {
+					t.Errorf("%s.%s has no migration", stmt.Schema.Table, field.DBName)
+				}
+			}
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		status, err := GetMigrationStatus(d)
+		if err != nil || len(status) != len(migrations) {
+			t.Fatalf("status: %v %v", status, err)
+		}
+		for _, state := range status {
+			if !state.Known || state.AppliedAt == nil {
+				t.Fatalf("migration %d not applied: %+v", state.Version, state)
+			}
+		}
+	})
+
+	t.Run("schema_too_new", func(t *testing.T) {
+		future := schemaMigration{Version: len(migrations) + 1, Name: "future", AppliedAt: time.Now()}
+		if err := d.Create(&future).Error; err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+		if err := Migrate(d); !errors.Is(err, ErrSchemaTooNew) {
+			t.Fatalf("migrate against a newer schema: %v", err)
+		}
+		status, _ := GetMigrationStatus(d)
+		if last := status[len(status)-1]; last.Known || last.Name != "future" {
+			t.Fatalf("unknown migration not reported: %+v", last)
+		}
+		if err := d.Delete(&future).Error; err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+	})
+
+	t.Run("down", func(t *testing.T) {
+		reverted, err := MigrateDown(d, len(migrations))
+		if err != nil || len(reverted) != len(migrations) || reverted[0].Version != len(migrations) {
+			t.Fatalf("down: %v %v", reverted, err)
+		}
+		if d.Migrator().HasTable(&models.ModelRelatedInformation{}) {
+			t.Fatalf("model_related_informations left after reverting every migration")
+		}
+		if _, err := MigrateUp(d, 0); err != nil {
+			t.Fatalf("up after down: %v", err)
+		}
+	})
+}
+
+func TestPostgresSearchIndexMatchesQuery(t *testing.T) {
+	script, err := migrationScripts.ReadFile("migrations/postgres/0002_registry.up.sql")
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !strings.Contains(string(script), searchDocument) {
+		t.Fatalf("the search index isn't built on %s, Postgres won't use it", searchDocument)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gorm.io/gorm"
+)
+
+var sortColumns = map[string]string{
+	models.SortModelName:    "model_name",
+	models.SortModelVersion: "model_version",
+	models.SortCreatedAt:    "created_at",
+}
+
+// Components of the artifact version as integers, so that 1.10.0 sorts after 1.9.0
+func artifactVersionColumns(dialect string) []string {
+	if dialect == "sqlite" {
+		// sqlite casts the leading digits of a text to an integer
+		minor := "substr(artifact_version, instr(artifact_version, '.') + 1)"
+		return []string{
+			"CAST(artifact_version AS INTEGER)",
+			"CAST(" + minor + " AS INTEGER)",
+			"CAST(substr(" + minor + ", instr(" + minor + ", '.') + 1) AS INTEGER)",
+		}
+	}
+	columns := []string{}
+	for _, part := range []string{"1", "2", "3"} {
+		columns = append(columns, "CAST(substring(split_part(artifact_version, '.', "+part+") from '^[0-9]+') AS INTEGER)")
+	}
+	return columns
+}
+
+// Matches a value of a comma separated list column
+func listContains(column string) string {
+	return "',' || REPLACE(" + column + ", ' ', '') || ',' LIKE '%,' || CAST(? AS TEXT) || ',%'"
+}
+
+func applyModelInfoFilters(tx *gorm.DB, query models.ModelInfoQuery) *gorm.DB {
+	if query.IncludeDeleted {
+		tx = tx.Unscoped()
+	}
+	for column, value := range map[string]string{
+		"model_name":      query.ModelName,
+		"model_version":   query.ModelVersion,
+		"lifecycle_state": query.LifecycleState,
+		"author":          query.Author,
+		"owner":           query.Owner,
+	} {
+		if value != "" {
+			tx = tx.Where(column+" = ?", value)
+		}
+	}
+	if query.InputDataType != "" {
+		tx = tx.Where(listContains("input_data_type"), strings.TrimSpace(query.InputDataType))
+	}
+	if query.OutputDataType != "" {
+		tx = tx.Where(listContains("output_data_type"), strings.TrimSpace(query.OutputDataType))
+	}
+	if query.PlatformName != "" || query.EnvironmentType != "" {
+		envs := tx.Session(&gorm.Session{NewDB: true}).
+			Model(&models.TargetEnvironment{}).
+			Select("model_related_information_id")
+		if query.PlatformName != "" {
+			envs = envs.Where("platform_name = ?", query.PlatformName)
+		}
+		if query.EnvironmentType != "" {
+			envs = envs.Where("environment_type = ?", query.EnvironmentType)
+		}
+		tx = tx.Where("id IN (?)", envs)
+	}
+	return tx
+}
+
+// Find returns a page of the registrations matching the query along with the number of matching registrations.
+// Registrations are ordered by the requested fields, then by model name and version so that pages are stable.
+func (repo *ModelInfoRepository) Find(query models.ModelInfoQuery) ([]models.ModelRelatedInformation, int64, error) {
+	var total int64
+	if err := applyModelInfoFilters(repo.db.Model(&models.ModelRelatedInformation{}), query).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	tx := applyModelInfoFilters(repo.db.Session(&gorm.Session{SkipHooks: true}), query)
+	for _, sort := range append(query.Sort, models.SortField{Field: models.SortModelName}, models.SortField{Field: models.SortModelVersion}) {
+		columns := []string{sortColumns[sort.Field]}
+		if sort.Field == models.SortArtifactVersion {
+			columns = artifactVersionColumns(repo.db.Dialector.Name())
+		}
+		for _, column := range columns {
+			if column == "" {
+				continue
+			}
+			if sort.Desc {
+				column += " DESC"
+			}
+			tx = tx.Order(column)
+		}
+	}
+	if query.Limit > 0 {
+		tx = tx.Limit(query.Limit)
+	}
+	if query.Offset > 0 {
+		tx = tx.Offset(query.Offset)
+	}
+
+	modelInfos := []models.ModelRelatedInformation{}
+	if err := tx.Find(&modelInfos).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := attachEnvsBatch(repo.db, modelInfos); err != nil {
+		return nil, 0, err
+	}
+	return modelInfos, total, nil
+}
@@ -0,0 +1,124 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+	"github.com/glebarez/sqlite"
+	"github.com/lib/pq"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Open connects to the database selected by DB_DRIVER, Postgres when it is empty
+func Open(dbConfig config.DBConfigData) (*gorm.DB, error) {
+	switch dbConfig.DB_DRIVER {
+	case "", config.DB_DRIVER_POSTGRES:
+		return openPostgres(dbConfig)
+	case config.DB_DRIVER_SQLITE:
+		return openSQLite(dbConfig.SQLITE_PATH)
+	}
+	return nil, fmt.Errorf("db_driver %q is not supported", dbConfig.DB_DRIVER)
+}
+
+func postgresDSN(dbConfig config.DBConfigData, dbName string) string {
+	return fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		dbConfig.PG_HOST,
+		dbConfig.PG_USER,
+		dbConfig.PG_PASSWORD,
+		dbName,
+		dbConfig.PG_PORT,
+	)
+}
+
+// Creates the database through the 'postgres' maintenance database when it is missing. Failures are
+// only logged, the user may not be allowed to create databases while the database already exists.
+func createPostgresDatabase(dbConfig config.DBConfigData) {
+	defaultDB, err := sql.Open("postgres", postgresDSN(dbConfig, "postgres"))
+	if err != nil {
+		logging.ERROR(fmt.Sprintf("Failed to connect to default database: %v", err))
+		return
+	}
+	defer defaultDB.Close()
+
+	var exists bool
+	err = defaultDB.QueryRow("SELECT EXISTS(SELECT 1 FROM pg_database WHERE datname = $1)", dbConfig.PG_DBNAME).Scan(&exists)
+	if err != nil {
+		logging.ERROR(fmt.Sprintf("Failed to check database existence: %v", err))
+		return
+	}
+	if exists {
+		logging.INFO(fmt.Sprintf("Database '%s' already exists.", dbConfig.PG_DBNAME))
+		return
+	}
+	if _, err = defaultDB.Exec("CREATE DATABASE " + pq.QuoteIdentifier(dbConfig.PG_DBNAME)); err != nil {
+		logging.ERROR(fmt.Sprintf("Failed to create database %s: %v", dbConfig.PG_DBNAME, err))
+		return
+	}
+	logging.INFO(fmt.Sprintf("Database '%s' created successfully.", dbConfig.PG_DBNAME))
+}
+
+func openPostgres(dbConfig config.DBConfigData) (*gorm.DB, error) {
+	createPostgresDatabase(dbConfig)
+	return gorm.Open(postgres.New(postgres.Config{
+		DSN:                  postgresDSN(dbConfig, dbConfig.PG_DBNAME),
+		PreferSimpleProtocol: true, // disables implicit prepared statement usage
+	}), &gorm.Config{})
+}
+
+/*
+Opens the SQLite database file, :memory: keeps it in memory for the life of the process. SQLite
+has a single writer, the pool is limited to one connection so the transactions queue up instead
+of failing as busy, which also keeps a :memory: database shared by every query.
+*/
+func openSQLite(path string) (*gorm.DB, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	db, err := gorm.Open(sqlite.Open(path+separator+"_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// Translates the gorm and driver errors the callers handle into the errors of this package, whatever the database
+func translateError(db *gorm.DB, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		if translated := translator.Translate(err); translated == gorm.ErrDuplicatedKey {
+			return fmt.Errorf("%w: %w", ErrConflict, err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,194 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package db
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"gorm.io/gorm"
+)
+
+const (
+	searchConfig = "english"
+	// text searched for a registration, the index of the 0002 migration is built on the same
+	// expression. concat_ws isn't immutable and can't be indexed.
+	searchText = "coalesce(description, '') || ' ' || coalesce(model_name, '') || ' ' || " +
+		"coalesce(author, '') || ' ' || coalesce(owner, '') || ' ' || " +
+		"replace(coalesce(input_data_type, ''), ',', ' ') || ' ' || replace(coalesce(output_data_type, ''), ',', ' ')"
+	searchDocument  = "to_tsvector('" + searchConfig + "', " + searchText + ")"
+	searchHighlight = "StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2"
+)
+
+// Columns searched when there is no full-text index
+var searchColumns = []string{"description", "model_name", "author", "owner", "input_data_type", "output_data_type"}
+
+type searchRow struct {
+	models.ModelRelatedInformation
+	Rank    float64
+	Snippet string
+}
+
+// Search returns a page of the registrations matching the text, most relevant first,
+// along with the number of matching registrations
+func (repo *ModelInfoRepository) Search(query models.SearchQuery) ([]models.SearchResult, int64, error) {
+	if repo.db.Dialector.Name() != "postgres" {
+		return repo.searchWithoutIndex(query)
+	}
+
+	match := searchDocument + " @@ websearch_to_tsquery('" + searchConfig + "', ?)"
+	var total int64
+	if err := repo.db.Model(&models.ModelRelatedInformation{}).Where(match, query.Text).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []searchRow
+	tx := repo.db.Session(&gorm.Session{SkipHooks: true}).
+		Model(&models.ModelRelatedInformation{}).
+		Select("*, ts_rank("+searchDocument+", websearch_to_tsquery('"+searchConfig+"', ?)) AS rank, "+
+			"ts_headline('"+searchConfig+"', "+searchText+", websearch_to_tsquery('"+searchConfig+"', ?), '"+searchHighlight+"') AS snippet",
+			query.Text, query.Text).
+		Where(match, query.Text).
+		Order("rank DESC").Order("model_name").Order("model_version")
+	if query.Limit > 0 {
+		tx = tx.Limit(query.Limit)
+	}
+	if query.Offset > 0 {
+		tx = tx.Offset(query.Offset)
+	}
+	if err := tx.Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]models.SearchResult, len(rows))
+	modelInfos := make([]models.ModelRelatedInformation, len(rows))
+	for i, row := range rows {
+		modelInfos[i] = row.ModelRelatedInformation
+	}
+	if err := attachEnvsBatch(repo.db, modelInfos); err != nil {
+		return nil, 0, err
+	}
+	for i, row := range rows {
+		results[i] = models.SearchResult{ModelInfo: modelInfos[i], Rank: row.Rank, Snippet: row.Snippet}
+	}
+	return results, total, nil
+}
+
+// Plain text fields searched when there is no full-text index
+func searchFields(m *models.ModelRelatedInformation) string {
+	return strings.Join([]string{
+		m.Description,
+		m.ModelId.ModelName,
+		m.ModelInformation.Metadata.Author,
+		m.ModelInformation.Metadata.Owner,
+		strings.ReplaceAll(m.ModelInformation.InputDataType, ",", " "),
+		strings.ReplaceAll(m.ModelInformation.OutputDataType, ",", " "),
+	}, " ")
+}
+
+// Every word has to be found, the rank is the number of occurrences of the words
+func (repo *ModelInfoRepository) searchWithoutIndex(query models.SearchQuery) ([]models.SearchResult, int64, error) {
+	words := strings.Fields(strings.ToLower(query.Text))
+	if len(words) == 0 {
+		return []models.SearchResult{}, 0, nil
+	}
+
+	tx := repo.db.Session(&gorm.Session{SkipHooks: true})
+	for _, word := range words {
+		matches := make([]string, len(searchColumns))
+		values := make([]any, len(searchColumns))
+		for i, column := range searchColumns {
+			matches[i] = "lower(" + column + ") LIKE ?"
+			values[i] = "%" + word + "%"
+		}
+		tx = tx.Where("("+strings.Join(matches, " OR ")+")", values...)
+	}
+	var modelInfos []models.ModelRelatedInformation
+	if err := tx.Find(&modelInfos).Error; err != nil {
+		return nil, 0, err
+	}
+
+	patterns := make([]string, len(words))
+	for i, word := range words {
+		patterns[i] = regexp.QuoteMeta(word)
+	}
+	matcher := regexp.MustCompile("(?i)" + strings.Join(patterns, "|"))
+
+	results := make([]models.SearchResult, 0, len(modelInfos))
+	for _, modelInfo := range modelInfos {
+		text := searchFields(&modelInfo)
+		matches := matcher.FindAllStringIndex(text, -1)
+		results = append(results, models.SearchResult{
+			ModelInfo: modelInfo,
+			Rank:      float64(len(matches)),
+			Snippet:   highlight(text, matches),
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		a, b := results[i].ModelInfo.ModelId, results[j].ModelInfo.ModelId
+		if a.ModelName != b.ModelName {
+			return a.ModelName < b.ModelName
+		}
+		return a.ModelVersion < b.ModelVersion
+	})
+
+	total := int64(len(results))
+	results = results[min(query.Offset, len(results)):]
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	modelInfos = make([]models.ModelRelatedInformation, len(results))
+	for i := range results {
+		modelInfos[i] = results[i].ModelInfo
+	}
+	if err := attachEnvsBatch(repo.db, modelInfos); err != nil {
+		return nil, 0, err
+	}
+	for i := range results {
+		results[i].ModelInfo = modelInfos[i]
+	}
+	return results, total, nil
+}
+
+// Wraps the matches in <mark></mark>, keeping some context around the first one
+func highlight(text string, matches [][]int) string {
+	const context = 60
+	if len(matches) == 0 {
+		return ""
+	}
+	start := max(matches[0][0]-context, 0)
+	end := min(matches[0][1]+context, len(text))
+
+	var snippet strings.Builder
+	last := start
+	for _, match := range matches {
+		if match[0] < last || match[1] > end {
+			continue
+		}
+		snippet.WriteString(text[last:match[0]])
+		snippet.WriteString("<mark>" + text[match[0]:match[1]] + "</mark>")
+		last = match[1]
+	}
+	snippet.WriteString(text[last:end])
+	return strings.TrimSpace(snippet.String())
+}
@@ -0,0 +1,61 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/consistency"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	modelDB "gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
+)
+
+// Runs the consistency check between the registrations and the storage, the report is
+// written to stdout. The exit code is 1 when inconsistencies are left unresolved.
+func runFsck(dbMgr core.DBMgr, iDB modelDB.IDB, modelFilePostfix string, args []string) int {
+	flags := flag.NewFlagSet("fsck", flag.ExitOnError)
+	repair := flags.Bool("repair", false, "roll registrations with a missing artifact back to the newest stored one")
+	deleteOrphans := flags.Bool("delete-orphans", false, "delete the objects no registration refers to")
+	gracePeriod := flags.Duration("grace-period", 10*time.Minute, "ignore objects modified more recently, they may be uploads in progress")
+	flags.Parse(args)
+
+	report, err := consistency.NewChecker(dbMgr, iDB, modelFilePostfix).Run(consistency.Options{
+		Repair:        *repair,
+		DeleteOrphans: *deleteOrphans,
+		GracePeriod:   *gracePeriod,
+	})
+	if err != nil {
+		logging.ERROR("Consistency check failed", "error", err)
+		return -1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logging.ERROR("Unable to write the report", "error", err)
+		return -1
+	}
+	if report.Unresolved() > 0 {
+		return 1
+	}
+	return 0
+}
@@ -18,8 +18,6 @@ limitations under the License.
 package main
 
 import (
-	"database/sql"
-	"fmt"
 	"net/http"
 	"os"
 	"time"
@@ -31,10 +29,21 @@ import (
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/routers"
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
 )
 
+// How often the registrations deleted for longer than their retention are purged
+const purgeInterval = time.Hour
+
+// How long a client may take to send the request headers, the body isn't limited by default as
+// the artifacts uploaded and downloaded can be large
+const readHeaderTimeout = 10 * time.Second
+
+// Parses a timeout of the configuration, validated already, an empty one means no timeout
+func serverTimeout(value string) time.Duration {
+	timeout, _ := time.ParseDuration(value)
+	return timeout
+}
+
 func main() {
 	if err := config.Load(config.NewConfigDataValidator(), config.NewEnvDataLoader(nil)); err != nil {
 		logging.ERROR("error in loading config", "error", err)
@@ -43,87 +52,41 @@ func main() {
 
 	configManager := config.GetConfigManager()
 	logging.INFO("config mgr prepared", "configmgr", configManager)
-	// setup the database connection
-
-	// Step 1: Connect to the default 'postgres' database
-	defaultDSN := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=postgres port=%s sslmode=disable",
-		configManager.DB.PG_HOST,
-		configManager.DB.PG_USER,
-		configManager.DB.PG_PASSWORD,
-		configManager.DB.PG_PORT,
-	)
 
-	defaultDB, err := sql.Open("postgres", defaultDSN)
+	// setup the database connection, Postgres or SQLite as configured
+	db, err := modelDB.Open(configManager.DB)
 	if err != nil {
-		logging.ERROR(fmt.Sprintf("Failed to connect to default database: %v", err))
+		logging.ERROR("database not available", "error", err)
+		os.Exit(-1)
 	}
-	defer defaultDB.Close()
-
-	// Step 2: Check if target database exists
-	var exists bool
-	checkQuery := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM pg_database WHERE datname = '%s')", configManager.DB.PG_DBNAME)
-	err = defaultDB.QueryRow(checkQuery).Scan(&exists)
-	if err != nil {
-		logging.ERROR(fmt.Sprintf("Failed to check database existence: %v", err))
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(db, os.Args[2:]))
 	}
-
-	// Step 3: Create database if missing
-	if !exists {
-		createQuery := fmt.Sprintf("CREATE DATABASE %s", configManager.DB.PG_DBNAME)
-		_, err = defaultDB.Exec(createQuery)
-		if err != nil {
-			logging.ERROR(fmt.Sprintf("Failed to create database %s: %v", configManager.DB.PG_DBNAME, err))
-		}
-		logging.INFO(fmt.Sprintf("Database '%s' created successfully.", configManager.DB.PG_DBNAME))
-	} else {
-		logging.INFO(fmt.Sprintf("Database '%s' already exists.", configManager.DB.PG_DBNAME))
+	// refuses to serve against a schema written by a newer version of the service
+	if err = modelDB.Migrate(db); err != nil {
+		logging.ERROR("Failed to migrate database", "error", err)
+		os.Exit(-1)
 	}
 
-	// connection string
-	DSN := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=%s port=%s sslmode=disable ",
-		configManager.DB.PG_HOST,
-		configManager.DB.PG_USER,
-		configManager.DB.PG_PASSWORD,
-		configManager.DB.PG_DBNAME,
-		configManager.DB.PG_PORT,
-	)
-
-	logging.INFO("Connection string for DB", DSN)
-	db, err := gorm.Open(postgres.New(postgres.Config{
-		DSN:                  DSN,
-		PreferSimpleProtocol: true, // disables implicit prepared statement usage
-	}), &gorm.Config{})
+	repo := modelDB.NewModelInfoRepository(db)
 
-	// db, err := gorm.Open(sqlite.Open("test.db"), &gorm.Config{})
-	if err != nil {
-		logging.ERROR("database not available")
-		os.Exit(-1)
+	if len(os.Args) > 1 && os.Args[1] == "fsck" {
+		os.Exit(runFsck(core.GetDBManagerInstance(), repo.WithAudit(models.AuditContext{Actor: models.SystemActor}), configManager.DB.MODEL_FILE_POSTFIX, os.Args[2:]))
 	}
 
-	// Auto migrate the scheme
-	err = db.AutoMigrate(
-		&models.ModelRelatedInformation{},
-		&models.TargetEnvironment{},
+	handler := apis.NewMmeApiHandler(
+		core.GetDBManagerInstance(),
+		repo,
 	)
-	if err != nil {
-		logging.ERROR("Failed to migrate database", "error", err)
-		os.Exit(-1)
-	}
-
-	repo := modelDB.NewModelInfoRepository(db)
+	go handler.PurgeDeletedModelsEvery(purgeInterval)
 
-	router := routers.InitRouter(
-		apis.NewMmeApiHandler(
-			core.GetDBManagerInstance(),
-			repo,
-		))
+	router := routers.InitRouter(handler)
 	server := http.Server{
-		Addr:         configManager.App.MMES_URL,
-		Handler:      router,
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		Addr:              configManager.App.MMES_URL,
+		Handler:           router,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       serverTimeout(configManager.App.HTTP_READ_TIMEOUT),
+		WriteTimeout:      serverTimeout(configManager.App.HTTP_WRITE_TIMEOUT),
 	}
 	logging.INFO("Starting api..")
 	err = server.ListenAndServe()
@@ -18,9 +18,21 @@ limitations under the License.
 
 package models
 
-type ProblemDetail struct{
-	Status int `json:"status"`
-	Title string `json:"title"`
-	Detail string `json:"detail"`
+// ProblemTypeBlank is the type of the problems only described by their status, RFC 7807
+const ProblemTypeBlank = "about:blank"
+
+// ProblemDetail is the body of every error response, served as application/problem+json (RFC 7807)
+type ProblemDetail struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalidParams,omitempty"`
 }
 
+// InvalidParam tells which field of the request is invalid and why
+type InvalidParam struct {
+	Param  string `json:"param"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,68 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+
+package models
+
+import "time"
+
+type PresignedUrlRequest struct {
+	// optional hex encoded SHA-256 the uploaded content has to match
+	Checksum string `json:"checksum"`
+	// optional bump level (major, minor, patch) or explicit MAJOR.MINOR.PATCH of the artifact
+	ArtifactVersion string `json:"artifactVersion,omitempty"`
+}
+
+type PresignedUrlResponse struct {
+	Url             string            `json:"url"`
+	Method          string            `json:"method"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	ArtifactVersion string            `json:"artifactVersion"`
+	ExpiresAt       time.Time         `json:"expiresAt"`
+}
+
+type CommitUploadRequest struct {
+	// optional hex encoded SHA-256 of the uploaded artifact, only compared with the one known to the storage
+	Checksum string `json:"checksum"`
+}
+
+// ArtifactInfo describes an artifact stored for a model
+type ArtifactInfo struct {
+	ArtifactVersion string    `json:"artifactVersion"`
+	ObjectName      string    `json:"objectName"`
+	Size            int64     `json:"size"`
+	LastModified    time.Time `json:"lastModified"`
+	// true for the artifact version recorded in the registration
+	Current  bool   `json:"current"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+type ModelArtifactsResponse struct {
+	ModelInfo ModelRelatedInformation `json:"modelInfo"`
+	Artifacts []ArtifactInfo          `json:"artifacts"`
+}
+
+// DeleteModelReport describes what happened to the stored artifacts of a purged registration
+type DeleteModelReport struct {
+	Id     string `json:"id"`
+	Policy string `json:"policy"`
+	// objects removed from the storage on purge, only filled by the cascade policy
+	RemovedObjects []string `json:"removedObjects"`
+	// objects the cascade policy failed to remove
+	FailedObjects []string `json:"failedObjects,omitempty"`
+	BucketRemoved bool     `json:"bucketRemoved"`
+}
@@ -0,0 +1,39 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+import "time"
+
+// AliasLatest always refers to the current artifact version of the registration, it is
+// maintained by the registry and can't be set or deleted
+const AliasLatest = "latest"
+
+// ArtifactAlias is a movable name, such as staging or production, for an artifact version
+// of a registered model version
+type ArtifactAlias struct {
+	ModelRelatedInformationID string    `gorm:"primaryKey" json:"modelRegistrationId"`
+	Name                      string    `gorm:"primaryKey" json:"alias"`
+	ArtifactVersion           string    `gorm:"not null" json:"artifactVersion"`
+	UpdatedAt                 time.Time `json:"updatedAt"`
+}
+
+func (ArtifactAlias) TableName() string { return "artifact_aliases" }
+
+type ArtifactAliasRequest struct {
+	ArtifactVersion string `json:"artifactVersion" validate:"required"`
+}
@@ -0,0 +1,61 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Status of an artifact version, uploads are recorded as available once committed
+const (
+	ArtifactStatusAvailable  = "available"
+	ArtifactStatusDeprecated = "deprecated"
+	ArtifactStatusRevoked    = "revoked"
+)
+
+// ArtifactVersion is an artifact committed for a registered model version, every upload
+// is kept while ModelID.ArtifactVersion only refers to the current one
+type ArtifactVersion struct {
+	ID                        string `gorm:"primaryKey" json:"-"`
+	ModelRelatedInformationID string `gorm:"uniqueIndex:idx_artifact_versions_registration_version;not null" json:"modelRegistrationId"`
+	Version                   string `gorm:"uniqueIndex:idx_artifact_versions_registration_version;not null" json:"artifactVersion"`
+	ObjectName                string `json:"objectName"`
+	Size                      int64  `json:"size"`
+	// hex encoded SHA-256 of the artifact
+	Digest     string    `json:"digest,omitempty"`
+	Status     string    `gorm:"not null" json:"status"`
+	UploadedBy string    `json:"uploadedBy,omitempty"`
+	UploadedAt time.Time `json:"uploadedAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func (ArtifactVersion) TableName() string { return "artifact_versions" }
+
+func (av *ArtifactVersion) BeforeCreate(tx *gorm.DB) error {
+	if av.ID == "" {
+		av.ID = uuid.NewString()
+	}
+	return nil
+}
+
+type ArtifactStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=available deprecated revoked"`
+}
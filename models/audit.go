@@ -0,0 +1,82 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Actions recorded in the audit trail
+const (
+	AuditRegistrationCreated  = "registration.created"
+	AuditRegistrationUpdated  = "registration.updated"
+	AuditRegistrationDeleted  = "registration.deleted"
+	AuditRegistrationRestored = "registration.restored"
+	AuditRegistrationPurged   = "registration.purged"
+	AuditArtifactCommitted    = "artifact.committed"
+	AuditArtifactStatus       = "artifact.status-changed"
+	AuditAliasSet             = "alias.set"
+	AuditAliasDeleted         = "alias.deleted"
+	AuditLifecycleTransition  = "lifecycle.transitioned"
+)
+
+// Actor of the changes made by the service itself, such as the periodic purge
+const SystemActor = "system"
+
+// AuditContext tells who is making the changes, and in which request
+type AuditContext struct {
+	Actor     string
+	RequestId string
+}
+
+// JSONText is a JSON document stored as text, it is marshaled as is
+type JSONText string
+
+func (j JSONText) MarshalJSON() ([]byte, error) {
+	if j == "" {
+		return []byte("null"), nil
+	}
+	return []byte(j), nil
+}
+
+// AuditEvent records a change of a registration or of one of its artifacts and aliases.
+// Before and After are snapshots of the changed entity, Diff is the JSON merge patch (RFC 7386)
+// turning Before into After. The audit trail is append-only and outlives the purge of the registration.
+type AuditEvent struct {
+	ID                        string    `gorm:"primaryKey" json:"id"`
+	ModelRelatedInformationID string    `gorm:"index;not null" json:"modelRegistrationId"`
+	Action                    string    `gorm:"not null" json:"action"`
+	Actor                     string    `json:"actor,omitempty"`
+	RequestId                 string    `json:"requestId,omitempty"`
+	Before                    JSONText  `json:"before"`
+	After                     JSONText  `json:"after"`
+	Diff                      JSONText  `json:"diff"`
+	CreatedAt                 time.Time `gorm:"index" json:"createdAt"`
+}
+
+func (AuditEvent) TableName() string { return "audit_events" }
+
+func (event *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+// Reasons an object is reported as orphaned
+const (
+	// the object belongs to no registered model version
+	OrphanUnregistered = "unregistered"
+	// the artifact version is newer than the current one and missing from the history
+	OrphanUncommitted = "uncommitted"
+	// leftover of an interrupted upload, under its staging name
+	OrphanStaging = "staging"
+)
+
+// MissingArtifact is an artifact of a registration, the current one or one recorded in its
+// history, which is not in the storage
+type MissingArtifact struct {
+	Id              string `json:"id"`
+	ModelName       string `json:"modelName"`
+	ModelVersion    string `json:"modelVersion"`
+	ArtifactVersion string `json:"artifactVersion"`
+	Bucket          string `json:"bucket"`
+	ObjectName      string `json:"objectName"`
+	// artifact version the registration was rolled back to, when repaired
+	RepairedTo string `json:"repairedTo,omitempty"`
+	// true when the artifact version, not the current one, was revoked as repair
+	Revoked bool `json:"revoked,omitempty"`
+}
+
+// OrphanedObject is an artifact in the storage no registration refers to
+type OrphanedObject struct {
+	Bucket     string `json:"bucket"`
+	ObjectName string `json:"objectName"`
+	Reason     string `json:"reason"`
+	Deleted    bool   `json:"deleted"`
+}
+
+type ConsistencyReport struct {
+	CheckedModels    int               `json:"checkedModels"`
+	CheckedObjects   int               `json:"checkedObjects"`
+	MissingArtifacts []MissingArtifact `json:"missingArtifacts"`
+	OrphanedObjects  []OrphanedObject  `json:"orphanedObjects"`
+}
+
+// Unresolved returns the number of inconsistencies which were neither repaired nor deleted
+func (report *ConsistencyReport) Unresolved() int {
+	unresolved := 0
+	for _, missing := range report.MissingArtifacts {
+		if missing.RepairedTo == "" && !missing.Revoked {
+			unresolved++
+		}
+	}
+	for _, orphan := range report.OrphanedObjects {
+		if !orphan.Deleted {
+			unresolved++
+		}
+	}
+	return unresolved
+}
@@ -0,0 +1,87 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Lifecycle states of a registration, every registration starts as registered
+const (
+	LifecycleRegistered = "registered"
+	LifecycleTrained    = "trained"
+	LifecycleValidated  = "validated"
+	LifecycleDeployed   = "deployed"
+	LifecycleDeprecated = "deprecated"
+	LifecycleArchived   = "archived"
+)
+
+// lifecycleTransitions lists the states reachable from each state, archived is final
+var lifecycleTransitions = map[string][]string{
+	LifecycleRegistered: {LifecycleTrained, LifecycleArchived},
+	LifecycleTrained:    {LifecycleValidated, LifecycleArchived},
+	// a model failing its validation goes back to training
+	LifecycleValidated:  {LifecycleDeployed, LifecycleTrained, LifecycleArchived},
+	LifecycleDeployed:   {LifecycleDeprecated},
+	LifecycleDeprecated: {LifecycleDeployed, LifecycleArchived},
+	LifecycleArchived:   {},
+}
+
+// ValidLifecycleState reports whether state is one of the lifecycle states
+func ValidLifecycleState(state string) bool {
+	_, ok := lifecycleTransitions[state]
+	return ok
+}
+
+// LifecycleTransitionAllowed reports whether a registration can be moved from one state to the other
+func LifecycleTransitionAllowed(from string, to string) bool {
+	for _, state := range lifecycleTransitions[from] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
+
+// LifecycleTransition records a change of the lifecycle state of a registration
+type LifecycleTransition struct {
+	ID                        string    `gorm:"primaryKey" json:"-"`
+	ModelRelatedInformationID string    `gorm:"index;not null" json:"modelRegistrationId"`
+	FromState                 string    `gorm:"not null" json:"fromState"`
+	ToState                   string    `gorm:"not null" json:"toState"`
+	Actor                     string    `json:"actor,omitempty"`
+	Reason                    string    `json:"reason,omitempty"`
+	CreatedAt                 time.Time `json:"createdAt"`
+}
+
+func (LifecycleTransition) TableName() string { return "lifecycle_transitions" }
+
+func (lt *LifecycleTransition) BeforeCreate(tx *gorm.DB) error {
+	if lt.ID == "" {
+		lt.ID = uuid.NewString()
+	}
+	return nil
+}
+
+type LifecycleTransitionRequest struct {
+	State  string `json:"state" validate:"required,oneof=registered trained validated deployed deprecated archived"`
+	Reason string `json:"reason"`
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -49,7 +51,7 @@ type ModelInformation struct {
 	Metadata          Metadata            `json:"metadata" gorm:"embedded" validate:"required"`
 	InputDataType     string              `json:"inputDataType" validate:"required"`  // this field will be a Comma Separated List
 	OutputDataType    string              `json:"outputDataType" validate:"required"` // this field will be a Comma Separated List
-	TargetEnvironment []TargetEnvironment `json:"targetEnvironment,omitempty" gorm:"-"`
+	TargetEnvironment []TargetEnvironment `json:"targetEnvironment,omitempty" gorm:"-" validate:"dive"`
 }
 
 type ModelID struct {
@@ -64,6 +66,18 @@ type ModelRelatedInformation struct {
 	Description      string           `json:"description" validate:"required"`
 	ModelInformation ModelInformation `json:"modelInformation" validate:"required" gorm:"embedded"`
 	ModelLocation    string           `json:"modelLocation"`
+	// hex encoded SHA-256 of the artifact stored for ModelId.ArtifactVersion
+	ArtifactChecksum string `json:"artifactChecksum,omitempty"`
+	// last artifact version handed out to an upload, only maintained by the repository
+	AllocatedArtifactVersion string `json:"-"`
+	// only moved through the lifecycle transitions, see LifecycleTransitionAllowed
+	LifecycleState string    `json:"lifecycleState" gorm:"not null;default:registered;index"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"index"`
+	// deleted registrations are kept, excluded from the queries, until they are purged
+	DeletedAt gorm.DeletedAt `json:"deletedAt" gorm:"index"`
+	DeletedBy string         `json:"deletedBy,omitempty"`
+	// incremented by every change of the registration, exposed as its ETag
+	RowVersion int64 `json:"-" gorm:"not null;default:1"`
 }
 
 type ModelInfoResponse struct {
@@ -0,0 +1,49 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+// Fields the model discovery can be sorted on
+const (
+	SortModelName       = "model-name"
+	SortModelVersion    = "model-version"
+	SortCreatedAt       = "created-at"
+	SortArtifactVersion = "artifact-version"
+)
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ModelInfoQuery selects a page of registrations, empty filters match everything
+type ModelInfoQuery struct {
+	ModelName       string
+	ModelVersion    string
+	LifecycleState  string
+	Author          string
+	Owner           string
+	InputDataType   string
+	OutputDataType  string
+	PlatformName    string
+	EnvironmentType string
+	// also return the deleted registrations which are not purged yet
+	IncludeDeleted bool
+	Sort           []SortField
+	Limit          int
+	Offset         int
+}
@@ -0,0 +1,34 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package models
+
+// SearchQuery is a free text search over the registrations, the words are all required
+// unless separated by "or", quoted phrases and words prefixed by '-' are honoured by Postgres
+type SearchQuery struct {
+	Text   string
+	Limit  int
+	Offset int
+}
+
+type SearchResult struct {
+	ModelInfo ModelRelatedInformation `json:"modelInfo"`
+	// relevance of the registration, only comparable within the same search
+	Rank float64 `json:"rank"`
+	// extract of the matched text, the matches are wrapped in <mark></mark>
+	Snippet string `json:"snippet"`
+}
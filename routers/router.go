@@ -25,23 +25,48 @@ import (
 func InitRouter(handler *apis.MmeApiHandler) *gin.Engine {
 	r := gin.New()
 	r.Use(gin.Logger())
-	r.Use(gin.Recovery())
+	r.Use(apis.Recovery())
+	r.Use(apis.RequestId())
+	r.NoRoute(apis.RouteNotFound)
 	api := r.Group("/ai-ml-model-registration/v1")
 	{
 		api.POST("/model-registrations", handler.RegisterModel)
 		api.POST("/model-registrations/updateArtifact/:modelname/:modelversion/:artifactversion", handler.UpdateArtifact) // Deprecated: use the new API reference: /uploadModel/:modelName/:modelVersion.
 		api.GET("/model-registrations/:modelRegistrationId", handler.GetModelInfoById)
 		api.PUT("/model-registrations/:modelRegistrationId", handler.UpdateModel)
+		api.PATCH("/model-registrations/:modelRegistrationId", handler.PatchModel)
 		api.DELETE("/model-registrations/:modelRegistrationId", handler.DeleteModel)
+		api.POST("/model-registrations/:modelRegistrationId/restore", handler.RestoreModel)
+		api.GET("/model-registrations/:modelRegistrationId/history", handler.ListAuditEvents)
+		api.GET("/model-registrations/:modelRegistrationId/artifacts", handler.ListArtifactVersions)
+		api.GET("/model-registrations/:modelRegistrationId/artifacts/:artifactVersion", handler.GetArtifactVersion)
+		api.PATCH("/model-registrations/:modelRegistrationId/artifacts/:artifactVersion", handler.MarkArtifactVersion)
+		api.GET("/model-registrations/:modelRegistrationId/aliases", handler.ListArtifactAliases)
+		api.PUT("/model-registrations/:modelRegistrationId/aliases/:alias", handler.SetArtifactAlias)
+		api.DELETE("/model-registrations/:modelRegistrationId/aliases/:alias", handler.DeleteArtifactAlias)
+		api.GET("/model-registrations/:modelRegistrationId/lifecycle", handler.ListLifecycleTransitions)
+		api.POST("/model-registrations/:modelRegistrationId/lifecycle", handler.TransitionLifecycleState)
 		api.GET("/getModelInfo/:modelName", handler.GetModelInfoByName)
 		api.POST("/uploadModel/:modelName/:modelVersion", handler.UploadModel)
 		api.GET("/downloadModel/:modelName/:modelVersion/:artifactVersion/model.zip", handler.DownloadModel)
+		api.HEAD("/downloadModel/:modelName/:modelVersion/:artifactVersion/model.zip", handler.DownloadModel)
+		api.POST("/presignedUpload/:modelName/:modelVersion", handler.PresignUpload)
+		api.POST("/commitUpload/:modelName/:modelVersion/:artifactVersion", handler.CommitUpload)
+		api.GET("/presignedDownload/:modelName/:modelVersion/:artifactVersion", handler.PresignDownload)
+		api.GET("/models/:modelName/versions/:modelVersion/artifacts", handler.ListModelArtifacts)
 	}
 	// As per R1-AP v6
 
 	modelDiscovery := r.Group("/ai-ml-model-discovery/v1")
 	{
 		modelDiscovery.GET("/models", handler.GetModelInfo)
+		modelDiscovery.GET("/search", handler.SearchModels)
+	}
+
+	admin := r.Group("/ai-ml-model-registration/v1/admin")
+	{
+		admin.POST("/consistency-check", handler.CheckConsistency)
+		admin.POST("/purge", handler.PurgeDeletedModelsNow)
 	}
 	return r
 }
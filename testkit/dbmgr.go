@@ -0,0 +1,236 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package testkit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+)
+
+type memoryObject struct {
+	data         []byte
+	lastModified time.Time
+}
+
+/*
+DBMgr is a core.DBMgr keeping the buckets in memory. It behaves as S3Manager does: the missing
+objects and buckets are reported as core.ErrObjectNotFound, deleting a missing object succeeds,
+a bucket is only removed once it is empty and uploading to a missing bucket creates it.
+
+The presigned URLs use the memory scheme and can't be fetched, a test plays the client which
+received one by calling UploadFile or GetBucketObject.
+*/
+type DBMgr struct {
+	mutex   sync.RWMutex
+	buckets map[string]map[string]memoryObject
+}
+
+var _ core.DBMgr = (*DBMgr)(nil)
+
+func NewDBMgr() *DBMgr {
+	return &DBMgr{buckets: map[string]map[string]memoryObject{}}
+}
+
+func objectNotFound(objectName string, bucketName string) error {
+	return fmt.Errorf("%w: %s/%s", core.ErrObjectNotFound, bucketName, objectName)
+}
+
+// Must be called with the mutex held
+func (dbMgr *DBMgr) object(objectName string, bucketName string) (memoryObject, error) {
+	object, found := dbMgr.buckets[bucketName][objectName]
+	if !found {
+		return memoryObject{}, objectNotFound(objectName, bucketName)
+	}
+	return object, nil
+}
+
+func (dbMgr *DBMgr) CreateBucket(bucketName string) (err error) {
+	dbMgr.mutex.Lock()
+	defer dbMgr.mutex.Unlock()
+	if _, found := dbMgr.buckets[bucketName]; !found {
+		dbMgr.buckets[bucketName] = map[string]memoryObject{}
+	}
+	return nil
+}
+
+func (dbMgr *DBMgr) GetBucketObject(objectName string, bucketName string) (*core.BucketObjectReader, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	object, err := dbMgr.object(objectName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	// the stored slices are never modified, an upload replaces them
+	return &core.BucketObjectReader{
+		ReadCloser: io.NopCloser(bytes.NewReader(object.data)),
+		ObjectInfo: core.ObjectInfo{Name: objectName, Size: int64(len(object.data)), LastModified: object.lastModified},
+	}, nil
+}
+
+func (dbMgr *DBMgr) GetBucketObjectRange(objectName string, bucketName string, offset int64, length int64) (*core.BucketObjectReader, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	object, err := dbMgr.object(objectName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(len(object.data))
+	if offset > size {
+		offset = size
+	}
+	if offset+length > size {
+		length = size - offset
+	}
+	return &core.BucketObjectReader{
+		ReadCloser: io.NopCloser(bytes.NewReader(object.data[offset : offset+length])),
+		ObjectInfo: core.ObjectInfo{Name: objectName, Size: length, LastModified: object.lastModified},
+	}, nil
+}
+
+func (dbMgr *DBMgr) HeadBucketObject(objectName string, bucketName string) (core.ObjectInfo, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	object, err := dbMgr.object(objectName, bucketName)
+	if err != nil {
+		return core.ObjectInfo{}, err
+	}
+	return core.ObjectInfo{Name: objectName, Size: int64(len(object.data)), LastModified: object.lastModified}, nil
+}
+
+func presignedURL(objectName string, bucketName string, expiry time.Duration) string {
+	return fmt.Sprintf("memory://%s/%s?expires=%d", url.PathEscape(bucketName), url.PathEscape(objectName), time.Now().Add(expiry).Unix())
+}
+
+func (dbMgr *DBMgr) SupportsPresign() bool {
+	return true
+}
+
+// Like S3Manager the bucket is created, so the upload through the URL would succeed
+func (dbMgr *DBMgr) PresignUpload(objectName string, bucketName string, checksum string, expiry time.Duration) (string, error) {
+	if err := dbMgr.CreateBucket(bucketName); err != nil {
+		return "", err
+	}
+	return presignedURL(objectName, bucketName, expiry), nil
+}
+
+func (dbMgr *DBMgr) PresignDownload(objectName string, bucketName string, expiry time.Duration) (string, error) {
+	return presignedURL(objectName, bucketName, expiry), nil
+}
+
+// Deletes the object and then the bucket, which is kept while other objects are left in it
+func (dbMgr *DBMgr) DeleteBucket(objectName string, bucketName string) {
+	dbMgr.mutex.Lock()
+	defer dbMgr.mutex.Unlock()
+	objects, found := dbMgr.buckets[bucketName]
+	if !found {
+		return
+	}
+	delete(objects, objectName)
+	if len(objects) == 0 {
+		delete(dbMgr.buckets, bucketName)
+	}
+}
+
+// Like S3, deleting a missing object succeeds but deleting from a missing bucket fails
+func (dbMgr *DBMgr) DeleteBucketObject(objectName string, bucketName string) bool {
+	dbMgr.mutex.Lock()
+	defer dbMgr.mutex.Unlock()
+	objects, found := dbMgr.buckets[bucketName]
+	if !found {
+		return false
+	}
+	delete(objects, objectName)
+	return true
+}
+
+func (dbMgr *DBMgr) MoveBucketObject(srcObjectName string, dstObjectName string, bucketName string) error {
+	dbMgr.mutex.Lock()
+	defer dbMgr.mutex.Unlock()
+	object, err := dbMgr.object(srcObjectName, bucketName)
+	if err != nil {
+		return err
+	}
+	delete(dbMgr.buckets[bucketName], srcObjectName)
+	object.lastModified = time.Now()
+	dbMgr.buckets[bucketName][dstObjectName] = object
+	return nil
+}
+
+// The data is read before the object is stored, a reader never observes a partial upload
+func (dbMgr *DBMgr) UploadFile(data io.Reader, file_name string, bucketName string) error {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	dbMgr.mutex.Lock()
+	defer dbMgr.mutex.Unlock()
+	if _, found := dbMgr.buckets[bucketName]; !found {
+		dbMgr.buckets[bucketName] = map[string]memoryObject{}
+	}
+	dbMgr.buckets[bucketName][file_name] = memoryObject{data: content, lastModified: time.Now()}
+	return nil
+}
+
+// Must be called with the mutex held
+func (dbMgr *DBMgr) bucketNames() []string {
+	names := make([]string, 0, len(dbMgr.buckets))
+	for name := range dbMgr.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (dbMgr *DBMgr) ListBucket(bucketObjPostfix string) ([]core.Bucket, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	bucketList := []core.Bucket{}
+	for _, name := range dbMgr.bucketNames() {
+		object, err := dbMgr.object(name+bucketObjPostfix, name)
+		if err != nil || len(object.data) == 0 {
+			continue
+		}
+		bucketList = append(bucketList, core.Bucket{Name: name, Object: append(core.BucketObject(nil), object.data...)})
+	}
+	return bucketList, nil
+}
+
+func (dbMgr *DBMgr) ListBucketNames() ([]string, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	return dbMgr.bucketNames(), nil
+}
+
+// Return list of objects in the bucket, a missing bucket has no objects
+func (dbMgr *DBMgr) GetBucketItems(bucketName string) ([]core.ObjectInfo, error) {
+	dbMgr.mutex.RLock()
+	defer dbMgr.mutex.RUnlock()
+	items := []core.ObjectInfo{}
+	for name, object := range dbMgr.buckets[bucketName] {
+		items = append(items, core.ObjectInfo{Name: name, Size: int64(len(object.data)), LastModified: object.lastModified})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return items, nil
+}
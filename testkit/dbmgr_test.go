@@ -0,0 +1,158 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package testkit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func readObject(t *testing.T, object *core.BucketObjectReader) string {
+	t.Helper()
+	defer object.Close()
+	content, err := io.ReadAll(object)
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestDBMgrObjects(t *testing.T) {
+	dbMgr := NewDBMgr()
+	assert.NoError(t, dbMgr.UploadFile(strings.NewReader("0123456789"), "model.zip", "bucket"))
+
+	object, err := dbMgr.GetBucketObject("model.zip", "bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), object.Size)
+	assert.Equal(t, "0123456789", readObject(t, object))
+
+	object, err = dbMgr.GetBucketObjectRange("model.zip", "bucket", 8, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), object.Size)
+	assert.Equal(t, "89", readObject(t, object))
+
+	info, err := dbMgr.HeadBucketObject("model.zip", "bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), info.Size)
+
+	assert.NoError(t, dbMgr.MoveBucketObject("model.zip", "moved.zip", "bucket"))
+	_, err = dbMgr.HeadBucketObject("model.zip", "bucket")
+	assert.ErrorIs(t, err, core.ErrObjectNotFound)
+	items, err := dbMgr.GetBucketItems("bucket")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "moved.zip", items[0].Name)
+}
+
+func TestDBMgrNotFound(t *testing.T) {
+	dbMgr := NewDBMgr()
+	assert.NoError(t, dbMgr.CreateBucket("bucket"))
+
+	for _, bucketName := range []string{"bucket", "missing"} {
+		_, err := dbMgr.GetBucketObject("model.zip", bucketName)
+		assert.ErrorIs(t, err, core.ErrObjectNotFound)
+		_, err = dbMgr.GetBucketObjectRange("model.zip", bucketName, 0, 1)
+		assert.ErrorIs(t, err, core.ErrObjectNotFound)
+		_, err = dbMgr.HeadBucketObject("model.zip", bucketName)
+		assert.ErrorIs(t, err, core.ErrObjectNotFound)
+		err = dbMgr.MoveBucketObject("model.zip", "moved.zip", bucketName)
+		assert.ErrorIs(t, err, core.ErrObjectNotFound)
+	}
+	// like S3, deleting a missing object succeeds unless its bucket is missing too
+	assert.True(t, dbMgr.DeleteBucketObject("model.zip", "bucket"))
+	assert.False(t, dbMgr.DeleteBucketObject("model.zip", "missing"))
+	items, err := dbMgr.GetBucketItems("missing")
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestDBMgrBuckets(t *testing.T) {
+	dbMgr := NewDBMgr()
+	assert.NoError(t, dbMgr.UploadFile(strings.NewReader("info"), "a_info.json", "a"))
+	assert.NoError(t, dbMgr.UploadFile(strings.NewReader("model"), "a_model.zip", "a"))
+	assert.NoError(t, dbMgr.UploadFile(strings.NewReader("model"), "b_model.zip", "b"))
+	assert.NoError(t, dbMgr.CreateBucket("c"))
+
+	names, err := dbMgr.ListBucketNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	buckets, err := dbMgr.ListBucket("_info.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []core.Bucket{{Name: "a", Object: core.BucketObject("info")}}, buckets)
+
+	// the bucket is kept while it isn't empty
+	dbMgr.DeleteBucket("a_info.json", "a")
+	names, _ = dbMgr.ListBucketNames()
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	dbMgr.DeleteBucket("a_model.zip", "a")
+	names, _ = dbMgr.ListBucketNames()
+	assert.Equal(t, []string{"b", "c"}, names)
+}
+
+func TestDBMgrPresign(t *testing.T) {
+	dbMgr := NewDBMgr()
+	assert.True(t, dbMgr.SupportsPresign())
+	url, err := dbMgr.PresignUpload("model.zip", "bucket", "", 0)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(url, "memory://bucket/model.zip?"), url)
+	names, _ := dbMgr.ListBucketNames()
+	assert.Equal(t, []string{"bucket"}, names)
+}
+
+func TestDBMgrConcurrentUploads(t *testing.T) {
+	dbMgr := NewDBMgr()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("%d.zip", i)
+			assert.NoError(t, dbMgr.UploadFile(bytes.NewReader([]byte(name)), name, "bucket"))
+			_, err := dbMgr.GetBucketItems("bucket")
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	items, err := dbMgr.GetBucketItems("bucket")
+	assert.NoError(t, err)
+	assert.Len(t, items, 20)
+}
+
+func TestDBMgrUploadFailure(t *testing.T) {
+	dbMgr := NewDBMgr()
+	failure := errors.New("connection reset")
+	err := dbMgr.UploadFile(io.MultiReader(strings.NewReader("part"), &failingReader{failure}), "model.zip", "bucket")
+	assert.ErrorIs(t, err, failure)
+	_, err = dbMgr.HeadBucketObject("model.zip", "bucket")
+	assert.ErrorIs(t, err, core.ErrObjectNotFound)
+}
+
+type failingReader struct {
+	err error
+}
+
+func (reader *failingReader) Read(p []byte) (int, error) {
+	return 0, reader.err
+}
@@ -0,0 +1,32 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+/*
+Package testkit provides storage for the service that needs neither Postgres nor S3, so the
+handlers can be exercised end to end: NewIDB opens the real db.ModelInfoRepository on a private
+SQLite :memory: database and NewDBMgr keeps the artifacts in an in-memory core.DBMgr.
+
+NewIDB is no fake of db.IDB, the queries, migrations and rules run are the ones of the service,
+only the database is SQLite instead of Postgres. NewDBMgr is a fake following the S3 behavior.
+
+	handler := apis.NewMmeApiHandler(testkit.NewDBMgr(), testkit.MustNewIDB())
+	router := routers.InitRouter(handler)
+
+Both implementations are safe for concurrent use and keep their content for their own lifetime
+only, every instance starts empty.
+*/
+package testkit
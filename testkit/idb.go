@@ -0,0 +1,48 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package testkit
+
+import (
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/config"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+)
+
+/*
+NewIDB returns a db.ModelInfoRepository opened on a private SQLite :memory: database. It is the
+repository the service runs with, so the uniqueness (db.ErrConflict), not-found (db.ErrNotFound),
+concurrency (db.ErrStale) and lifecycle rules are exactly the ones of a deployment.
+*/
+func NewIDB() (*db.ModelInfoRepository, error) {
+	database, err := db.Open(config.DBConfigData{DB_DRIVER: config.DB_DRIVER_SQLITE, SQLITE_PATH: ":memory:"})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Migrate(database); err != nil {
+		return nil, err
+	}
+	return db.NewModelInfoRepository(database), nil
+}
+
+// MustNewIDB is like NewIDB but panics when the database can't be created
+func MustNewIDB() *db.ModelInfoRepository {
+	repo, err := NewIDB()
+	if err != nil {
+		panic(err)
+	}
+	return repo
+}
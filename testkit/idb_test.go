@@ -0,0 +1,90 @@
+/*
+==================================================================================
+Copyright (c) 2026 Samsung Electronics Co., Ltd. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+==================================================================================
+*/
+package testkit
+
+import (
+	"sync"
+	"testing"
+
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/db"
+	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func registration(name string, version string) models.ModelRelatedInformation {
+	return models.ModelRelatedInformation{
+		ModelId:     models.ModelID{ModelName: name, ModelVersion: version, ArtifactVersion: "0.0.0"},
+		Description: "test",
+		ModelInformation: models.ModelInformation{
+			Metadata:       models.Metadata{Author: "tester"},
+			InputDataType:  "csv",
+			OutputDataType: "json",
+		},
+	}
+}
+
+func TestIDBSemantics(t *testing.T) {
+	repo := MustNewIDB()
+	assert.NoError(t, repo.Create(registration("model", "1")))
+	assert.ErrorIs(t, repo.Create(registration("model", "1")), db.ErrConflict)
+
+	_, err := repo.GetModelInfoById("missing")
+	assert.ErrorIs(t, err, db.ErrNotFound)
+	assert.ErrorIs(t, repo.Update(registration("model", "2")), db.ErrNotFound)
+
+	stored, err := repo.GetModelInfoByNameAndVer("model", "1")
+	assert.NoError(t, err)
+	stored.RowVersion = 2
+	assert.ErrorIs(t, repo.Update(*stored), db.ErrStale)
+}
+
+func TestIDBIsolated(t *testing.T) {
+	first, second := MustNewIDB(), MustNewIDB()
+	assert.NoError(t, first.Create(registration("model", "1")))
+	assert.NoError(t, second.Create(registration("model", "1")))
+	all, err := second.GetAll()
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestIDBConcurrentAllocations(t *testing.T) {
+	repo := MustNewIDB()
+	assert.NoError(t, repo.Create(registration("model", "1")))
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	allocated := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			version, err := repo.AllocateArtifactVersion("model", "1", "")
+			if !assert.NoError(t, err) {
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if allocated[version] {
+				t.Errorf("allocated twice: %s", version)
+			}
+			allocated[version] = true
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, allocated, 10)
+}
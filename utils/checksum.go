@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+const checksumPrefix = "sha256:"
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// NormalizeChecksum validates a hex encoded SHA-256 checksum, optionally
+// prefixed with "sha256:", and returns it as lower case hex
+func NormalizeChecksum(checksum string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(checksum))
+	normalized = strings.TrimPrefix(normalized, checksumPrefix)
+	if len(normalized) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid sha256 checksum: %s", checksum)
+	}
+	if _, err := hex.DecodeString(normalized); err != nil {
+		return "", fmt.Errorf("invalid sha256 checksum: %s", checksum)
+	}
+	return normalized, nil
+}
+
+// DigestHeader converts a hex encoded SHA-256 checksum into the value of
+// an RFC 3230 Digest header
+func DigestHeader(checksum string) string {
+	sum, err := hex.DecodeString(checksum)
+	if err != nil {
+		return ""
+	}
+	return "sha-256=" + base64.StdEncoding.EncodeToString(sum)
+}
+
+// ChecksumReader computes the SHA-256 checksum of everything read through it
+type ChecksumReader struct {
+	reader io.Reader
+	hash   hash.Hash
+}
+
+func NewChecksumReader(reader io.Reader) *ChecksumReader {
+	hash := sha256.New()
+	return &ChecksumReader{
+		reader: io.TeeReader(reader, hash),
+		hash:   hash,
+	}
+}
+
+func (c *ChecksumReader) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+// Checksum returns the hex encoded checksum of the data read so far
+func (c *ChecksumReader) Checksum() string {
+	return hex.EncodeToString(c.hash.Sum(nil))
+}
+
+type verifyingReader struct {
+	checksum *ChecksumReader
+	reader   *bufio.Reader
+	expected string
+}
+
+// NewVerifyingReader returns a reader which fails with ErrChecksumMismatch
+// instead of io.EOF when the data read does not match the expected checksum.
+// The last byte is held back on a mismatch, so a corrupted stream never
+// reaches its full length.
+func NewVerifyingReader(reader io.Reader, expected string) io.Reader {
+	checksum := NewChecksumReader(reader)
+	return &verifyingReader{
+		checksum: checksum,
+		reader:   bufio.NewReader(checksum),
+		expected: expected,
+	}
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	if err == nil {
+		// the data is verified by the read returning its last byte
+		if _, peekErr := v.reader.Peek(1); peekErr != io.EOF {
+			return n, nil
+		}
+	} else if err != io.EOF {
+		return n, err
+	}
+	if actual := v.checksum.Checksum(); actual != v.expected {
+		if n > 0 {
+			n--
+		}
+		return n, fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, v.expected, actual)
+	}
+	return n, err
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sha256 of "hello world"
+const helloChecksum = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+func TestNormalizeChecksum(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expected       string
+		expected_error bool
+	}{
+		{"Plain", helloChecksum, helloChecksum, false},
+		{"Prefixed", "sha256:" + helloChecksum, helloChecksum, false},
+		{"UpperCase", strings.ToUpper(helloChecksum), helloChecksum, false},
+		{"TooShort", "abcd", "", true},
+		{"NotHex", strings.Repeat("z", 64), "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NormalizeChecksum(tc.input)
+			assert.Equal(t, tc.expected_error, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDigestHeader(t *testing.T) {
+	assert.Equal(t, "sha-256=uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=", DigestHeader(helloChecksum))
+	assert.Equal(t, "", DigestHeader("not-hex"))
+}
+
+func TestChecksumReader(t *testing.T) {
+	reader := NewChecksumReader(strings.NewReader("hello world"))
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+	assert.Equal(t, helloChecksum, reader.Checksum())
+}
+
+func TestVerifyingReader(t *testing.T) {
+	data, err := io.ReadAll(NewVerifyingReader(strings.NewReader("hello world"), helloChecksum))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	data, err = io.ReadAll(NewVerifyingReader(strings.NewReader("hello there"), helloChecksum))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	// the last byte isn't returned
+	assert.Equal(t, "hello ther", string(data))
+}
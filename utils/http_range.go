@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// ByteRange is a single range of a representation, in bytes
+type ByteRange struct {
+	Start  int64
+	Length int64
+}
+
+// ParseByteRange parses the Range header of a request for a representation of the given size.
+// Only a single byte range is honoured, nil is returned when the whole representation has to be
+// served (no header, an unsupported unit, several ranges or an invalid header, see RFC 9110 14.2).
+// ErrRangeNotSatisfiable is returned when the range doesn't overlap the representation.
+func ParseByteRange(header string, size int64) (*ByteRange, error) {
+	spec, found := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return nil, nil
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return nil, nil
+	}
+
+	if first == "" {
+		// suffix range, the last N bytes
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix < 0 {
+			return nil, nil
+		}
+		if suffix == 0 || size == 0 {
+			return nil, ErrRangeNotSatisfiable
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return &ByteRange{Start: size - suffix, Length: suffix}, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return nil, nil
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	if start >= size {
+		return nil, ErrRangeNotSatisfiable
+	}
+	return &ByteRange{Start: start, Length: end - start + 1}, nil
+}
+
+// ContentRange returns the value of the Content-Range header for the range
+func (r ByteRange) ContentRange(size int64) string {
+	return "bytes " + strconv.FormatInt(r.Start, 10) + "-" + strconv.FormatInt(r.Start+r.Length-1, 10) + "/" + strconv.FormatInt(size, 10)
+}
+
+// ETagMatches reports whether an If-None-Match header matches the etag, using the weak comparison
+func ETagMatches(header string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// IfRangeMatches reports whether an If-Range header still designates the current representation,
+// so the requested range can be served. Entity tags use the strong comparison, otherwise the
+// header is a date which must be equal to the last modification time.
+func IfRangeMatches(header string, etag string, lastModified time.Time) bool {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return true
+	}
+	if strings.HasPrefix(header, `"`) || strings.HasPrefix(header, "W/") {
+		return etag != "" && !strings.HasPrefix(etag, "W/") && header == etag
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+	return !lastModified.IsZero() && date.Equal(lastModified.Truncate(time.Second))
+}
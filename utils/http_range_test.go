@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseByteRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected *ByteRange
+		err      error
+	}{
+		{"NoHeader", "", nil, nil},
+		{"Closed", "bytes=0-9", &ByteRange{Start: 0, Length: 10}, nil},
+		{"Open", "bytes=90-", &ByteRange{Start: 90, Length: 10}, nil},
+		{"EndClamped", "bytes=95-200", &ByteRange{Start: 95, Length: 5}, nil},
+		{"Suffix", "bytes=-20", &ByteRange{Start: 80, Length: 20}, nil},
+		{"SuffixLargerThanSize", "bytes=-200", &ByteRange{Start: 0, Length: 100}, nil},
+		{"StartBeyondSize", "bytes=100-", nil, ErrRangeNotSatisfiable},
+		{"EmptySuffix", "bytes=-0", nil, ErrRangeNotSatisfiable},
+		{"MultipleRanges", "bytes=0-1,5-6", nil, nil},
+		{"OtherUnit", "items=0-1", nil, nil},
+		{"Malformed", "bytes=a-b", nil, nil},
+		{"Reversed", "bytes=9-0", nil, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseByteRange(tc.header, 100)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestContentRange(t *testing.T) {
+	assert.Equal(t, "bytes 10-19/100", ByteRange{Start: 10, Length: 10}.ContentRange(100))
+}
+
+func TestETagMatches(t *testing.T) {
+	assert.True(t, ETagMatches(`"abc"`, `"abc"`))
+	assert.True(t, ETagMatches(`"x", W/"abc"`, `"abc"`))
+	assert.True(t, ETagMatches(`*`, `"abc"`))
+	assert.False(t, ETagMatches(`"abd"`, `"abc"`))
+	assert.False(t, ETagMatches(`"abc"`, ""))
+}
+
+func TestIfRangeMatches(t *testing.T) {
+	lastModified := time.Date(2025, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	assert.True(t, IfRangeMatches("", `"abc"`, lastModified))
+	assert.True(t, IfRangeMatches(`"abc"`, `"abc"`, lastModified))
+	assert.False(t, IfRangeMatches(`"abd"`, `"abc"`, lastModified))
+	assert.False(t, IfRangeMatches(`W/"abc"`, `W/"abc"`, lastModified))
+	assert.True(t, IfRangeMatches(lastModified.Format(http.TimeFormat), "", lastModified))
+	assert.False(t, IfRangeMatches(lastModified.Add(time.Hour).Format(http.TimeFormat), "", lastModified))
+	assert.False(t, IfRangeMatches("garbage", `"abc"`, lastModified))
+}
@@ -0,0 +1,270 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type jsonPatchOperation struct {
+	Op    string
+	Path  string
+	From  string
+	Value interface{}
+	// whether the operation has a value member, null being a value
+	hasValue bool
+}
+
+func (operation *jsonPatchOperation) UnmarshalJSON(data []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	for key, target := range map[string]*string{"op": &operation.Op, "path": &operation.Path, "from": &operation.From} {
+		if raw, found := members[key]; found {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	if raw, found := members["value"]; found {
+		operation.hasValue = true
+		return json.Unmarshal(raw, &operation.Value)
+	}
+	return nil
+}
+
+// ApplyJSONPatch applies the JSON patch (RFC 6902) to the document. The operations are applied in
+// order, the patch is rejected as a whole when one of them fails, including a failed test.
+func ApplyJSONPatch(document []byte, patch []byte) ([]byte, error) {
+	var documentValue interface{}
+	if err := json.Unmarshal(document, &documentValue); err != nil {
+		return nil, err
+	}
+	var operations []jsonPatchOperation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, err
+	}
+	for i, operation := range operations {
+		var err error
+		if documentValue, err = applyJSONPatchOperation(documentValue, operation); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+	return json.Marshal(documentValue)
+}
+
+func applyJSONPatchOperation(document interface{}, operation jsonPatchOperation) (interface{}, error) {
+	path, err := parseJSONPointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch operation.Op {
+	case "add", "replace", "test":
+		if !operation.hasValue {
+			return nil, fmt.Errorf("missing value")
+		}
+	case "move", "copy":
+		from, err := parseJSONPointer(operation.From)
+		if err != nil {
+			return nil, fmt.Errorf("from: %w", err)
+		}
+		value, err := jsonPointerGet(document, from)
+		if err != nil {
+			return nil, fmt.Errorf("from: %w", err)
+		}
+		if operation.Op == "copy" {
+			return jsonPointerAdd(document, path, deepCopyJSON(value))
+		}
+		if len(from) < len(path) && reflect.DeepEqual(from, path[:len(from)]) {
+			return nil, fmt.Errorf("can't move a value into itself")
+		}
+		if document, err = jsonPointerRemove(document, from); err != nil {
+			return nil, err
+		}
+		return jsonPointerAdd(document, path, value)
+	}
+
+	switch operation.Op {
+	case "add":
+		return jsonPointerAdd(document, path, operation.Value)
+	case "remove":
+		return jsonPointerRemove(document, path)
+	case "replace":
+		return jsonPointerReplace(document, path, operation.Value)
+	case "test":
+		value, err := jsonPointerGet(document, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, operation.Value) {
+			return nil, fmt.Errorf("test failed")
+		}
+		return document, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", operation.Op)
+}
+
+// Splits a JSON pointer (RFC 6901) into its unescaped reference tokens
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// Parses an array index, end is allowed to point just past the last element
+func jsonArrayIndex(token string, length int, end bool) (int, error) {
+	if end && token == "-" {
+		return length, nil
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (len(token) > 1 && token[0] == '0') || strings.HasPrefix(token, "+") {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if index > length || (index == length && !end) {
+		return 0, fmt.Errorf("array index %d out of bounds", index)
+	}
+	return index, nil
+}
+
+func jsonPointerGet(document interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch node := document.(type) {
+		case map[string]interface{}:
+			value, found := node[token]
+			if !found {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			document = value
+		case []interface{}:
+			index, err := jsonArrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			document = node[index]
+		default:
+			return nil, fmt.Errorf("can't reference %q in a scalar", token)
+		}
+	}
+	return document, nil
+}
+
+// Rebuilds the document with the parent of the path changed by change, arrays may be reallocated
+func jsonPointerUpdate(document interface{}, path []string, change func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return change(document, path[0])
+	}
+	child, err := jsonPointerGet(document, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = jsonPointerUpdate(child, path[1:], change); err != nil {
+		return nil, err
+	}
+	switch node := document.(type) {
+	case map[string]interface{}:
+		node[path[0]] = child
+	case []interface{}:
+		index, _ := jsonArrayIndex(path[0], len(node), false)
+		node[index] = child
+	}
+	return document, nil
+}
+
+func jsonPointerAdd(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return jsonPointerUpdate(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			index, err := jsonArrayIndex(token, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		return nil, fmt.Errorf("can't add %q to a scalar", token)
+	})
+}
+
+func jsonPointerReplace(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return jsonPointerUpdate(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, found := node[token]; !found {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			index, err := jsonArrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			node[index] = value
+			return node, nil
+		}
+		return nil, fmt.Errorf("can't replace %q in a scalar", token)
+	})
+}
+
+func jsonPointerRemove(document interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("can't remove the whole document")
+	}
+	return jsonPointerUpdate(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, found := node[token]; !found {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []interface{}:
+			index, err := jsonArrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:index], node[index+1:]...), nil
+		}
+		return nil, fmt.Errorf("can't remove %q from a scalar", token)
+	})
+}
+
+func deepCopyJSON(value interface{}) interface{} {
+	switch node := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(node))
+		for key, child := range node {
+			copied[key] = deepCopyJSON(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(node))
+		for i, child := range node {
+			copied[i] = deepCopyJSON(child)
+		}
+		return copied
+	}
+	return value
+}
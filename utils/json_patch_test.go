@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+	}{
+		{"AddMember", `{"a":1}`, `[{"op":"add","path":"/b","value":[1]}]`, `{"a":1,"b":[1]}`},
+		{"AddNull", `{"a":1}`, `[{"op":"add","path":"/b","value":null}]`, `{"a":1,"b":null}`},
+		{"AddToArray", `{"l":[1,3]}`, `[{"op":"add","path":"/l/1","value":2}]`, `{"l":[1,2,3]}`},
+		{"AppendToArray", `{"l":[1]}`, `[{"op":"add","path":"/l/-","value":2}]`, `{"l":[1,2]}`},
+		{"Remove", `{"a":1,"l":[1,2]}`, `[{"op":"remove","path":"/a"},{"op":"remove","path":"/l/0"}]`, `{"l":[2]}`},
+		{"Replace", `{"a":{"b":1}}`, `[{"op":"replace","path":"/a/b","value":"x"}]`, `{"a":{"b":"x"}}`},
+		{"ReplaceRoot", `{"a":1}`, `[{"op":"replace","path":"","value":{"b":2}}]`, `{"b":2}`},
+		{"Move", `{"a":{"b":1},"c":{}}`, `[{"op":"move","from":"/a/b","path":"/c/d"}]`, `{"a":{},"c":{"d":1}}`},
+		{"Copy", `{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, `{"a":{"b":1},"c":{"b":2}}`},
+		{"Test", `{"a":[1,"x"]}`, `[{"op":"test","path":"/a","value":[1,"x"]}]`, `{"a":[1,"x"]}`},
+		{"EscapedPointer", `{"a/b":{"c~d":1}}`, `[{"op":"replace","path":"/a~1b/c~0d","value":2}]`, `{"a/b":{"c~d":2}}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ApplyJSONPatch([]byte(tc.document), []byte(tc.patch))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(got))
+		})
+	}
+}
+
+func TestApplyJSONPatchInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"NotAnArray", `{"op":"remove","path":"/a"}`},
+		{"UnknownOp", `[{"op":"merge","path":"/a"}]`},
+		{"MissingValue", `[{"op":"add","path":"/b"}]`},
+		{"InvalidPointer", `[{"op":"remove","path":"a"}]`},
+		{"RemoveMissing", `[{"op":"remove","path":"/b"}]`},
+		{"ReplaceMissing", `[{"op":"replace","path":"/b","value":1}]`},
+		{"IndexOutOfBounds", `[{"op":"add","path":"/l/3","value":1}]`},
+		{"LeadingZeroIndex", `[{"op":"remove","path":"/l/01"}]`},
+		{"MoveIntoItself", `[{"op":"move","from":"/l","path":"/l/0"}]`},
+		{"FailedTest", `[{"op":"test","path":"/a","value":"1"}]`},
+		{"ThroughScalar", `[{"op":"add","path":"/a/b","value":1}]`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ApplyJSONPatch([]byte(`{"a":1,"l":[1,2]}`), []byte(tc.patch))
+			assert.Error(t, err)
+		})
+	}
+}
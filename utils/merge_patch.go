@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// CreateMergePatch returns the JSON merge patch (RFC 7386) turning the before document into the
+// after one. An empty document stands for a missing one, the patch is then null or the whole document.
+func CreateMergePatch(before []byte, after []byte) ([]byte, error) {
+	var beforeValue, afterValue interface{}
+	if len(before) > 0 {
+		if err := json.Unmarshal(before, &beforeValue); err != nil {
+			return nil, err
+		}
+	}
+	if len(after) > 0 {
+		if err := json.Unmarshal(after, &afterValue); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(mergePatch(beforeValue, afterValue))
+}
+
+func mergePatch(before interface{}, after interface{}) interface{} {
+	beforeObject, beforeIsObject := before.(map[string]interface{})
+	afterObject, afterIsObject := after.(map[string]interface{})
+	if !beforeIsObject || !afterIsObject {
+		// anything but objects is replaced as a whole
+		return after
+	}
+	patch := map[string]interface{}{}
+	for key, beforeValue := range beforeObject {
+		afterValue, found := afterObject[key]
+		if !found {
+			patch[key] = nil
+		} else if !reflect.DeepEqual(beforeValue, afterValue) {
+			patch[key] = mergePatch(beforeValue, afterValue)
+		}
+	}
+	for key, afterValue := range afterObject {
+		if _, found := beforeObject[key]; !found {
+			patch[key] = afterValue
+		}
+	}
+	return patch
+}
+
+// ApplyMergePatch applies the JSON merge patch (RFC 7386) to the document. Members of the patch
+// set to null are removed from the document, arrays and other values are replaced as a whole.
+func ApplyMergePatch(document []byte, patch []byte) ([]byte, error) {
+	var documentValue, patchValue interface{}
+	if err := json.Unmarshal(document, &documentValue); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, err
+	}
+	return json.Marshal(applyMergePatch(documentValue, patchValue))
+}
+
+func applyMergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, patchIsObject := patch.(map[string]interface{})
+	if !patchIsObject {
+		return patch
+	}
+	targetObject, targetIsObject := target.(map[string]interface{})
+	if !targetIsObject {
+		targetObject = map[string]interface{}{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = applyMergePatch(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
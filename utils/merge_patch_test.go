@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected string
+	}{
+		{"Created", "", `{"a":1}`, `{"a":1}`},
+		{"Removed", `{"a":1}`, "", `null`},
+		{"Unchanged", `{"a":1,"b":[1,2]}`, `{"a":1,"b":[1,2]}`, `{}`},
+		{"Changed", `{"a":1,"b":"x"}`, `{"a":2,"b":"x"}`, `{"a":2}`},
+		{"AddedAndDropped", `{"a":1}`, `{"b":2}`, `{"a":null,"b":2}`},
+		{"Nested", `{"m":{"x":1,"y":1}}`, `{"m":{"x":1,"y":2}}`, `{"m":{"y":2}}`},
+		{"ArrayReplaced", `{"l":[1,2]}`, `{"l":[2]}`, `{"l":[2]}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CreateMergePatch([]byte(tc.before), []byte(tc.after))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(got))
+		})
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+	}{
+		{"Changed", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"Added", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"Removed", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"ArrayReplaced", `{"a":["b"]}`, `{"a":["c","d"]}`, `{"a":["c","d"]}`},
+		{"Nested", `{"a":{"b":"c","d":"e"}}`, `{"a":{"b":"d","d":null}}`, `{"a":{"b":"d"}}`},
+		{"ObjectReplacesScalar", `{"a":"b"}`, `{"a":{"c":null,"d":1}}`, `{"a":{"d":1}}`},
+		{"NotAnObject", `{"a":"b"}`, `["c"]`, `["c"]`},
+		{"Empty", `{"a":"b"}`, `{}`, `{"a":"b"}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ApplyMergePatch([]byte(tc.document), []byte(tc.patch))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(got))
+		})
+	}
+}
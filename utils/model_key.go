@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ModelObjectName returns the name under which an artifact is stored in the bucket of its model
+func ModelObjectName(modelName string, modelVersion string, artifactVersion string, postfix string) string {
+	return fmt.Sprintf("%s_%s_%s", modelName, modelVersion, artifactVersion) + postfix
+}
+
+// ModelBucketName returns the name of the bucket holding the artifacts of a model
+func ModelBucketName(modelName string) string {
+	return strings.ToLower(modelName)
+}
+
+// ParseModelObjectName returns the artifact version of an object stored for the given model,
+// ok is false when the object doesn't hold an artifact of that model name and version
+func ParseModelObjectName(objectName string, modelName string, modelVersion string, postfix string) (artifactVersion string, ok bool) {
+	rest, found := strings.CutPrefix(objectName, fmt.Sprintf("%s_%s_", modelName, modelVersion))
+	if !found {
+		return "", false
+	}
+	artifactVersion, found = strings.CutSuffix(rest, postfix)
+	if !found {
+		return "", false
+	}
+	if _, err := ParseArtifactVersion(artifactVersion); err != nil {
+		return "", false
+	}
+	return artifactVersion, true
+}
+
+// SplitModelObjectName splits the name of an object stored in the bucket of a model into the
+// "<modelName>_<modelVersion>" key and the artifact version, without knowing the model beforehand.
+// ok is false when the object isn't named like an artifact of a model stored in that bucket.
+func SplitModelObjectName(objectName string, bucketName string, postfix string) (modelKey string, artifactVersion string, ok bool) {
+	rest, found := strings.CutSuffix(objectName, postfix)
+	if !found {
+		return "", "", false
+	}
+	separator := strings.LastIndex(rest, "_")
+	if separator < 0 {
+		return "", "", false
+	}
+	modelKey, artifactVersion = rest[:separator], rest[separator+1:]
+	if _, err := ParseArtifactVersion(artifactVersion); err != nil {
+		return "", "", false
+	}
+	if !strings.HasPrefix(strings.ToLower(modelKey), bucketName+"_") {
+		return "", "", false
+	}
+	return modelKey, artifactVersion, true
+}
+
+// Artifacts are uploaded under a staging name first and only moved to their final name once
+// the whole content is stored, no model name can start with the prefix as buckets can't
+const stagingPrefix = "~staging~"
+
+// StagingObjectName returns the temporary name of an upload in progress
+func StagingObjectName(objectName string, uploadId string) string {
+	return stagingPrefix + uploadId + "~" + objectName
+}
+
+// IsStagingObjectName reports whether the object is the leftover of an upload in progress
+func IsStagingObjectName(objectName string) bool {
+	return strings.HasPrefix(objectName, stagingPrefix)
+}
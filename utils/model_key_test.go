@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelObjectName(t *testing.T) {
+	assert.Equal(t, "Qoe_v1_1.2.0_model.zip", ModelObjectName("Qoe", "v1", "1.2.0", "_model.zip"))
+	assert.Equal(t, "qoe", ModelBucketName("Qoe"))
+}
+
+func TestParseModelObjectName(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectName string
+		expected   string
+		ok         bool
+	}{
+		{"Artifact", "qoe_v1_1.2.0_model.zip", "1.2.0", true},
+		{"OtherModelVersion", "qoe_v2_1.2.0_model.zip", "", false},
+		{"LongerModelVersion", "qoe_v1_x_1.2.0_model.zip", "", false},
+		{"InfoFile", "qoe_info.json", "", false},
+		{"OtherPostfix", "qoe_v1_1.2.0.tar", "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ParseModelObjectName(tc.objectName, "qoe", "v1", "_model.zip")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestSplitModelObjectName(t *testing.T) {
+	tests := []struct {
+		name            string
+		objectName      string
+		modelKey        string
+		artifactVersion string
+		ok              bool
+	}{
+		{"Artifact", "Qoe_v1_1.2.0_model.zip", "Qoe_v1", "1.2.0", true},
+		{"UnderscoreInModelVersion", "qoe_v1_x_1.2.0_model.zip", "qoe_v1_x", "1.2.0", true},
+		{"InfoFile", "qoe_info.json", "", "", false},
+		{"NotSemver", "qoe_v1_latest_model.zip", "", "", false},
+		{"OtherBucket", "kpi_v1_1.2.0_model.zip", "", "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			modelKey, artifactVersion, ok := SplitModelObjectName(tc.objectName, "qoe", "_model.zip")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.modelKey, modelKey)
+			assert.Equal(t, tc.artifactVersion, artifactVersion)
+		})
+	}
+}
+
+func TestStagingObjectName(t *testing.T) {
+	staging := StagingObjectName("qoe_v1_1.2.0_model.zip", "42")
+	assert.Equal(t, "~staging~42~qoe_v1_1.2.0_model.zip", staging)
+	assert.True(t, IsStagingObjectName(staging))
+	assert.False(t, IsStagingObjectName("qoe_v1_1.2.0_model.zip"))
+
+	_, ok := ParseModelObjectName(staging, "qoe", "v1", "_model.zip")
+	assert.False(t, ok)
+	_, _, ok = SplitModelObjectName(staging, "qoe", "_model.zip")
+	assert.False(t, ok)
+}
@@ -1,40 +1,137 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"gerrit.o-ran-sc.org/r/aiml-fw/awmf/modelmgmtservice/logging"
 )
 
-func IncrementArtifactVersion(artifactVersion string) (string, error) {
+// Levels of an artifact version bump, the minor number is bumped when none is given
+const (
+	BumpMajor = "major"
+	BumpMinor = "minor"
+	BumpPatch = "patch"
+)
+
+var (
+	ErrInvalidArtifactVersion      = errors.New("invalid artifact version")
+	ErrArtifactVersionNotIncreased = errors.New("artifact version must be greater than the last one")
+)
+
+// Aliases start with a letter, so they are never mistaken for an artifact version
+var artifactAliasPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+// ValidArtifactAlias reports whether name can be used as an artifact alias
+func ValidArtifactAlias(name string) bool {
+	return artifactAliasPattern.MatchString(name)
+}
+
+// ArtifactVersion is a parsed MAJOR.MINOR.PATCH artifact version
+type ArtifactVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+func (v ArtifactVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower, equal or greater than other
+func (v ArtifactVersion) Compare(other ArtifactVersion) int {
+	for _, diff := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+func ParseArtifactVersion(artifactVersion string) (ArtifactVersion, error) {
 	parts := strings.Split(artifactVersion, ".")
 	if len(parts) != 3 {
-		logging.ERROR("invalid artifactVersion format: " + artifactVersion)
-		return "", fmt.Errorf("invalid artifactVersion format: %s", artifactVersion)
+		return ArtifactVersion{}, fmt.Errorf("invalid artifactVersion format: %s", artifactVersion)
 	}
 
 	major, err1 := strconv.Atoi(parts[0])
 	minor, err2 := strconv.Atoi(parts[1])
 	patch, err3 := strconv.Atoi(parts[2])
 	if err1 != nil || err2 != nil || err3 != nil {
-		logging.ERROR(fmt.Sprintf("failed to parse artifactVersion numbers: %v, %v, %v", err1, err2, err3))
-		return "", fmt.Errorf("failed to parse artifactVersion numbers: %v, %v, %v", err1, err2, err3)
+		return ArtifactVersion{}, fmt.Errorf("failed to parse artifactVersion numbers: %v, %v, %v", err1, err2, err3)
+	}
+	return ArtifactVersion{Major: major, Minor: minor, Patch: patch}, nil
+}
+
+// CompareArtifactVersions compares two artifact versions numerically, versions which can't be
+// parsed are ordered before valid ones and between themselves as strings
+func CompareArtifactVersions(a string, b string) int {
+	versionA, errA := ParseArtifactVersion(a)
+	versionB, errB := ParseArtifactVersion(b)
+	switch {
+	case errA == nil && errB == nil:
+		return versionA.Compare(versionB)
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// IncrementArtifactVersion bumps the minor number of the artifact version, 0.0.0 becomes 1.0.0
+func IncrementArtifactVersion(artifactVersion string) (string, error) {
+	next, err := NextArtifactVersion(artifactVersion, BumpMinor)
+	if err != nil {
+		logging.ERROR(err.Error())
+		return "", err
 	}
+	return next, nil
+}
+
+// IsArtifactVersionBump reports whether the requested artifact version is a bump level rather than
+// an explicit version, no level requests the default increment
+func IsArtifactVersionBump(requested string) bool {
+	return requested == "" || requested == BumpMajor || requested == BumpMinor || requested == BumpPatch
+}
 
-	// Increment logic
-	if artifactVersion == "0.0.0" {
-		// Modify to 1.0.0
-		major = 1
-		minor = 0
-		patch = 0
-	} else {
-		// Change from 1.x.0 to 1.(x + 1).0
-		minor += 1
+// NextArtifactVersion returns the artifact version following last. The requested version is either
+// a bump level, empty for the default increment, or an explicit MAJOR.MINOR.PATCH which has to be
+// strictly greater than last.
+func NextArtifactVersion(last string, requested string) (string, error) {
+	version, err := ParseArtifactVersion(last)
+	if err != nil {
+		return "", err
 	}
 
-	// Construct new version string
-	newArtifactVersion := fmt.Sprintf("%d.%d.%d", major, minor, patch)
-	return newArtifactVersion, nil
+	switch requested {
+	case BumpMajor:
+		version = ArtifactVersion{Major: version.Major + 1}
+	case "", BumpMinor:
+		version = ArtifactVersion{Major: version.Major, Minor: version.Minor + 1}
+	case BumpPatch:
+		version.Patch += 1
+	default:
+		explicit, err := ParseArtifactVersion(requested)
+		if err != nil || explicit.Major < 0 || explicit.Minor < 0 || explicit.Patch < 0 || explicit.String() != requested {
+			return "", fmt.Errorf("%w: %q is neither a bump level (major, minor, patch) nor a MAJOR.MINOR.PATCH version", ErrInvalidArtifactVersion, requested)
+		}
+		if explicit.Compare(version) <= 0 {
+			return "", fmt.Errorf("%w: %s is not greater than %s", ErrArtifactVersionNotIncreased, requested, last)
+		}
+		return requested, nil
+	}
+
+	// 0.0.0 only marks a registration without artifact, so the first one is always 1.0.0
+	if last == "0.0.0" {
+		version = ArtifactVersion{Major: 1}
+	}
+	return version.String(), nil
 }
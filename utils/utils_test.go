@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,3 +36,74 @@ func TestIncrementArtifactVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareArtifactVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.1", "1.0.0", 1},
+		{"invalid", "0.0.0", -1},
+		{"1.0.0", "invalid", 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CompareArtifactVersions(tc.a, tc.b))
+		})
+	}
+}
+
+func TestNextArtifactVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		last      string
+		requested string
+		expected  string
+		err       error
+	}{
+		{"Default", "1.5.2", "", "1.6.0", nil},
+		{"Major", "1.5.2", "major", "2.0.0", nil},
+		{"Minor", "1.5.2", "minor", "1.6.0", nil},
+		{"Patch", "1.5.2", "patch", "1.5.3", nil},
+		{"FirstArtifact", "0.0.0", "patch", "1.0.0", nil},
+		{"Explicit", "1.5.2", "1.10.0", "1.10.0", nil},
+		{"ExplicitFirstArtifact", "0.0.0", "0.1.0", "0.1.0", nil},
+		{"ExplicitEqual", "1.5.2", "1.5.2", "", ErrArtifactVersionNotIncreased},
+		{"ExplicitLower", "1.5.2", "1.4.9", "", ErrArtifactVersionNotIncreased},
+		{"UnknownLevel", "1.5.2", "build", "", ErrInvalidArtifactVersion},
+		{"LeadingZero", "1.5.2", "01.6.0", "", ErrInvalidArtifactVersion},
+		{"Negative", "1.5.2", "2.-1.0", "", ErrInvalidArtifactVersion},
+		{"Prerelease", "1.5.2", "2.0.0-rc1", "", ErrInvalidArtifactVersion},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NextArtifactVersion(tc.last, tc.requested)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestIsArtifactVersionBump(t *testing.T) {
+	for _, requested := range []string{"", BumpMajor, BumpMinor, BumpPatch} {
+		assert.True(t, IsArtifactVersionBump(requested), requested)
+	}
+	for _, requested := range []string{"1.0.0", "Minor", "v2"} {
+		assert.False(t, IsArtifactVersionBump(requested), requested)
+	}
+}
+
+func TestValidArtifactAlias(t *testing.T) {
+	for _, alias := range []string{"latest", "staging", "production", "canary-2", "a"} {
+		assert.True(t, ValidArtifactAlias(alias), alias)
+	}
+	for _, alias := range []string{"", "1.0.0", "Production", "-staging", "pre release", "a/b", strings.Repeat("a", 64)} {
+		assert.False(t, ValidArtifactAlias(alias), alias)
+	}
+}